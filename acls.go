@@ -17,6 +17,8 @@ package goisilon
 
 import (
 	"context"
+	"path"
+	"sync"
 
 	api "github.com/dell/goisilon/api/v2"
 )
@@ -83,3 +85,140 @@ func (c *Client) SetVolumeMode(
 			Mode:          &filemode,
 		})
 }
+
+// ACE is an access control entry of an ACL.
+type ACE = api.ACE
+
+// NewUserACE returns an ACE of accessType, api.ACEAccessTypeAllow or
+// api.ACEAccessTypeDeny, for the user name. The name is resolved by the
+// cluster when the ACE is applied.
+func NewUserACE(name, accessType string, rights ...string) *ACE {
+	return newACE(name, api.PersonaIDTypeUser, accessType, rights)
+}
+
+// NewGroupACE returns an ACE of accessType for the group name, see NewUserACE.
+func NewGroupACE(name, accessType string, rights ...string) *ACE {
+	return newACE(name, api.PersonaIDTypeGroup, accessType, rights)
+}
+
+func newACE(name string, idType api.PersonaIDType, accessType string, rights []string) *ACE {
+	return &ACE{
+		Trustee: &api.Persona{
+			ID: &api.PersonaID{
+				ID:   name,
+				Type: idType,
+			},
+		},
+		AccessType:   accessType,
+		AccessRights: rights,
+	}
+}
+
+// AddVolumeACE adds ace to the ACL of a volume.
+func (c *Client) AddVolumeACE(
+	ctx context.Context,
+	volumeName string, ace *ACE,
+) error {
+	return c.updateVolumeACE(ctx, volumeName, api.ACEOpAdd, ace)
+}
+
+// RemoveVolumeACE removes ace from the ACL of a volume.
+func (c *Client) RemoveVolumeACE(
+	ctx context.Context,
+	volumeName string, ace *ACE,
+) error {
+	return c.updateVolumeACE(ctx, volumeName, api.ACEOpDelete, ace)
+}
+
+func (c *Client) updateVolumeACE(
+	ctx context.Context,
+	volumeName, op string, ace *ACE,
+) error {
+	entry := *ace
+	entry.Op = op
+
+	return api.ACLUpdate(
+		ctx,
+		c.API,
+		volumeName,
+		&api.ACL{
+			Action:        &api.PActionTypeUpdate,
+			Authoritative: &api.PAuthoritativeTypeACL,
+			ACL:           []*ACE{&entry},
+		})
+}
+
+// SetVolumeACL sets the ACL of a volume.
+func (c *Client) SetVolumeACL(
+	ctx context.Context,
+	volumeName string, acl ACL,
+) error {
+	return api.ACLUpdate(ctx, c.API, volumeName, acl)
+}
+
+// SetVolumeACLRecursive sets the ACL of a volume and of every file and
+// directory in it. At most ConcurrentHTTPConnections ACLs are set at once,
+// and the first error stops the update.
+func (c *Client) SetVolumeACLRecursive(
+	ctx context.Context,
+	volumeName string, acl ACL,
+) error {
+	if err := c.SetVolumeACL(ctx, volumeName, acl); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		vpl        = len(c.API.VolumesPath()) + 1
+		setACLWait = &sync.WaitGroup{}
+		setACLChan = newConcurrentHTTPChan()
+		errOnce    sync.Once
+		firstErr   error
+	)
+	fail := func(err error) {
+		errOnce.Do(func() {
+			firstErr = err
+			cancel()
+		})
+	}
+
+	queryChan, queryErrs := api.ContainerChildrenGetQuery(
+		ctx, c.API, volumeName, 1000, -1, "", "", nil,
+		[]string{"name", "container_path"})
+
+	for queryChan != nil || queryErrs != nil {
+		select {
+		case child, ok := <-queryChan:
+			if !ok {
+				queryChan = nil
+				continue
+			}
+			if ctx.Err() != nil {
+				continue
+			}
+			childPath := path.Join(*child.Path, *child.Name)[vpl:]
+			<-setACLChan
+			setACLWait.Add(1)
+			go func() {
+				defer setACLWait.Done()
+				defer func() { setACLChan <- true }()
+				if err := c.SetVolumeACL(ctx, childPath, acl); err != nil {
+					fail(err)
+				}
+			}()
+		case err, ok := <-queryErrs:
+			if !ok {
+				queryErrs = nil
+				continue
+			}
+			if err != nil {
+				fail(err)
+			}
+		}
+	}
+	setACLWait.Wait()
+
+	return firstErr
+}
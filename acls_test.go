@@ -16,10 +16,16 @@ limitations under the License.
 package goisilon
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
 	"testing"
 
+	api "github.com/dell/goisilon/api/v2"
 	"github.com/dell/goisilon/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestGetVolumeACL(t *testing.T) {
@@ -51,3 +57,56 @@ func TestSetVolumeMode(t *testing.T) {
 	err := client.SetVolumeMode(defaultCtx, "test_set_volume_owner", 777)
 	assert.Nil(t, err)
 }
+
+func TestAddAndRemoveVolumeACE(t *testing.T) {
+	ctx := context.Background()
+	client := &Client{API: new(mocks.Client)}
+	client.API.(*mocks.Client).On("VolumesPath", anyArgs[0:6]...).Return("/ifs/volumes")
+
+	ace := NewGroupACE("staff", api.ACEAccessTypeAllow, "dir_gen_read")
+	for _, op := range []string{api.ACEOpAdd, api.ACEOpDelete} {
+		client.API.(*mocks.Client).On("Put", ctx, "namespace/ifs/volumes", "vol", mock.Anything, mock.Anything, mock.Anything, nil).Return(nil).Run(func(args mock.Arguments) {
+			data, err := json.Marshal(args.Get(5))
+			assert.NoError(t, err)
+			assert.JSONEq(t, `{"authoritative":"acl","action":"update","acl":[{"trustee":{"id":"group:staff"},
+				"accesstype":"allow","accessrights":["dir_gen_read"],"op":"`+op+`"}]}`, string(data))
+		}).Once()
+	}
+	assert.NoError(t, client.AddVolumeACE(ctx, "vol", ace))
+	assert.NoError(t, client.RemoveVolumeACE(ctx, "vol", ace))
+	assert.Empty(t, ace.Op)
+	client.API.(*mocks.Client).AssertExpectations(t)
+}
+
+func TestSetVolumeACLRecursive(t *testing.T) {
+	ctx := context.Background()
+	client := &Client{API: new(mocks.Client)}
+	client.API.(*mocks.Client).On("VolumesPath", anyArgs[0:6]...).Return("/ifs/volumes")
+	client.API.(*mocks.Client).On("Get", mock.Anything, "namespace/ifs/volumes", "vol", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		err := json.Unmarshal([]byte(`{"children":[
+			{"name":"a","container_path":"/ifs/volumes/vol"},
+			{"name":"b","container_path":"/ifs/volumes/vol"},
+			{"name":"c","container_path":"/ifs/volumes/vol/b"}]}`), args.Get(5))
+		assert.NoError(t, err)
+	})
+
+	var (
+		mu  sync.Mutex
+		set []string
+	)
+	client.API.(*mocks.Client).On("Put", mock.Anything, "namespace/ifs/volumes", mock.Anything, mock.Anything, mock.Anything, mock.Anything, nil).Return(nil).Run(func(args mock.Arguments) {
+		mu.Lock()
+		defer mu.Unlock()
+		set = append(set, args.String(2))
+	})
+	acl := &api.ACL{Action: &api.PActionTypeReplace, Authoritative: &api.PAuthoritativeTypeACL,
+		ACL: []*ACE{NewUserACE("alice", api.ACEAccessTypeAllow, "dir_gen_all")}}
+	assert.NoError(t, client.SetVolumeACLRecursive(ctx, "vol", acl))
+	assert.ElementsMatch(t, []string{"vol", "vol/a", "vol/b", "vol/b/c"}, set)
+
+	client.API.(*mocks.Client).ExpectedCalls = nil
+	client.API.(*mocks.Client).On("VolumesPath", anyArgs[0:6]...).Return("/ifs/volumes")
+	client.API.(*mocks.Client).On("Put", mock.Anything, "namespace/ifs/volumes", "vol", mock.Anything, mock.Anything, mock.Anything, nil).Return(nil).Once()
+	client.API.(*mocks.Client).On("Get", mock.Anything, "namespace/ifs/volumes", "vol", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("query failed")).Once()
+	assert.EqualError(t, client.SetVolumeACLRecursive(ctx, "vol", acl), "query failed")
+}
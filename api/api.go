@@ -196,6 +196,16 @@ type HTMLError struct {
 	Message    string
 }
 
+// StreamResponse may be passed as the resp of a request to copy the body of a
+// successful response to Body instead of decoding it as JSON.
+type StreamResponse struct {
+	// Body receives the response body.
+	Body io.Writer
+	// OnHeader, if set, is called with the status code and headers of the
+	// response before its body is copied.
+	OnHeader func(statusCode int, header http.Header)
+}
+
 // ClientOptions are options for the API client.
 type ClientOptions struct {
 	// Insecure is a flag that indicates whether or not to supress SSL errors.
@@ -403,7 +413,13 @@ var doWithHeadersFunc = func(c *client, ctx context.Context, method string, uri
 			logrus.Printf("Error closing HTTP response: %s", err.Error())
 		}
 	}()
-	logResponse(ctx, res, c.verboseLogging)
+	stream, isStream := resp.(*StreamResponse)
+	if isStream && c.verboseLogging == VerboseHigh {
+		// do not buffer a streamed body for logging
+		logResponse(ctx, res, VerboseMedium)
+	} else {
+		logResponse(ctx, res, c.verboseLogging)
+	}
 
 	// parse the response
 	switch {
@@ -413,6 +429,13 @@ var doWithHeadersFunc = func(c *client, ctx context.Context, method string, uri
 		if resp == nil {
 			return nil
 		}
+		if isStream {
+			if stream.OnHeader != nil {
+				stream.OnHeader(res.StatusCode, res.Header)
+			}
+			_, err = io.Copy(stream.Body, res.Body)
+			return err
+		}
 		dec := json.NewDecoder(res.Body)
 		if err = dec.Decode(resp); err != nil && err != io.EOF {
 			return err
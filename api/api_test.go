@@ -475,6 +475,36 @@ func TestDoWithHeaders(t *testing.T) {
 	assert.Equal(t, expectedResp, resp)
 }
 
+func TestDoWithHeaders_StreamResponse(t *testing.T) {
+	c := &client{
+		http: http.DefaultClient,
+	}
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "bytes=0-4", r.Header.Get("Range"))
+		w.Header().Set("ETag", "v1")
+		w.WriteHeader(http.StatusPartialContent)
+		w.Write([]byte("hello"))
+	}))
+	defer server.Close()
+	c.hostname = server.URL
+
+	var buf bytes.Buffer
+	var status int
+	resp := &StreamResponse{
+		Body: &buf,
+		OnHeader: func(statusCode int, header http.Header) {
+			status = statusCode
+			assert.Equal(t, "v1", header.Get("ETag"))
+		},
+	}
+	err := c.DoWithHeaders(ctx, http.MethodGet, "namespace", "ifs/file", nil, map[string]string{"Range": "bytes=0-4"}, nil, resp)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusPartialContent, status)
+	assert.Equal(t, "hello", buf.String())
+}
+
 func TestClient_APIVersion(t *testing.T) {
 	c := &client{apiVersion: 1}
 	assert.Equal(t, uint8(1), c.APIVersion())
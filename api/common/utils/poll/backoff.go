@@ -0,0 +1,132 @@
+/*
+Copyright (c) 2025 Dell Inc, or its subsidiaries.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package poll
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+// ErrWaitCanceled is returned, wrapping ctx.Err(), when the context of a poll
+// is canceled before the condition is met.
+var ErrWaitCanceled = errors.New("canceled waiting for the condition")
+
+// Backoff computes the delays between the attempts of a poll.
+type Backoff struct {
+	// Interval is the delay after the first attempt.
+	Interval time.Duration
+	// Factor multiplies the delay after every attempt. Values up to 1 keep
+	// the delay constant.
+	Factor float64
+	// Jitter adds a random delay of up to Jitter times the delay, e.g. 0.1
+	// for up to 10%.
+	Jitter float64
+	// Cap is the largest delay before jitter, 0 means no cap.
+	Cap time.Duration
+}
+
+// Step returns the delay to wait after the given delay. A zero delay yields Interval.
+func (b Backoff) Step(delay time.Duration) time.Duration {
+	if delay <= 0 {
+		delay = b.Interval
+	} else if b.Factor > 1 {
+		delay = time.Duration(float64(delay) * b.Factor)
+	}
+	if b.Cap > 0 && delay > b.Cap {
+		delay = b.Cap
+	}
+	return delay
+}
+
+// jitter returns delay with the random jitter of b added.
+func (b Backoff) jitter(delay time.Duration) time.Duration {
+	if b.Jitter <= 0 {
+		return delay
+	}
+	return delay + time.Duration(rand.Float64()*b.Jitter*float64(delay)) // #nosec G404
+}
+
+// Progress describes an attempt of a poll that did not meet the condition.
+type Progress[T any] struct {
+	Attempt int
+	Elapsed time.Duration
+	// Value is what the condition observed in this attempt.
+	Value T
+}
+
+// Options configures PollUntil.
+type Options[T any] struct {
+	Backoff Backoff
+	// Timeout bounds the poll, 0 means no bound other than the context.
+	Timeout time.Duration
+	// OnProgress is called after every attempt that did not meet the condition.
+	OnProgress func(Progress[T])
+}
+
+// PollUntil calls condition immediately and then after every backoff delay
+// until it reports done or returns an error, and returns the last value it
+// observed. The condition is called with ctx.
+//
+// If Timeout elapses or ctx hits its deadline first, the error wraps
+// ErrWaitTimeout. If ctx is canceled first, the error wraps ErrWaitCanceled
+// and ctx.Err().
+func PollUntil[T any](ctx context.Context, opts Options[T], condition func(context.Context) (T, bool, error)) (T, error) {
+	if opts.Backoff.Interval <= 0 {
+		var zero T
+		return zero, errors.New("poll interval must be positive")
+	}
+	var timeout <-chan time.Time
+	if opts.Timeout > 0 {
+		timer := time.NewTimer(opts.Timeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	start := time.Now()
+	var delay time.Duration
+	for attempt := 1; ; attempt++ {
+		value, done, err := condition(ctx)
+		if err != nil || done {
+			return value, err
+		}
+		if opts.OnProgress != nil {
+			opts.OnProgress(Progress[T]{Attempt: attempt, Elapsed: time.Since(start), Value: value})
+		}
+
+		delay = opts.Backoff.Step(delay)
+		wait := time.NewTimer(opts.Backoff.jitter(delay))
+		select {
+		case <-wait.C:
+		case <-timeout:
+			wait.Stop()
+			return value, ErrWaitTimeout
+		case <-ctx.Done():
+			wait.Stop()
+			return value, contextError(ctx)
+		}
+	}
+}
+
+// contextError tells a context that hit its deadline from a canceled one.
+func contextError(ctx context.Context) error {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("%w: %w", ErrWaitTimeout, ctx.Err())
+	}
+	return fmt.Errorf("%w: %w", ErrWaitCanceled, ctx.Err())
+}
@@ -30,10 +30,15 @@ type (
 	ConditionWithContextFunc func(context.Context) (done bool, err error)
 )
 
+// ImmediateWithContext checks condition immediately and then every interval
+// until it is met, timeout elapses or ctx is done. See PollUntil for the
+// errors returned when ctx is done.
 func ImmediateWithContext(ctx context.Context, interval, timeout time.Duration, condition ConditionWithContextFunc) error {
 	return poll(ctx, true, poller(interval, timeout), condition)
 }
 
+// WaitForWithContext checks fn every time wait signals until it is met, wait
+// is closed or ctx is done. See PollUntil for the errors returned when ctx is done.
 func WaitForWithContext(ctx context.Context, wait WaitWithContextFunc, fn ConditionWithContextFunc) error {
 	waitCtx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -52,7 +57,7 @@ func WaitForWithContext(ctx context.Context, wait WaitWithContextFunc, fn Condit
 				return ErrWaitTimeout
 			}
 		case <-ctx.Done():
-			return ErrWaitTimeout
+			return contextError(ctx)
 		}
 	}
 }
@@ -70,7 +75,7 @@ func poll(ctx context.Context, immediate bool, wait WaitWithContextFunc, conditi
 
 	select {
 	case <-ctx.Done():
-		return ErrWaitTimeout
+		return contextError(ctx)
 	default:
 		return WaitForWithContext(ctx, wait, condition)
 	}
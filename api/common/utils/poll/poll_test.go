@@ -114,8 +114,26 @@ func TestWaitForWithContext_Timeout(t *testing.T) {
 	}
 
 	err := WaitForWithContext(ctx, wait, condition)
-	assert.Error(t, err)
-	assert.Equal(t, ErrWaitTimeout, err)
+	assert.ErrorIs(t, err, ErrWaitTimeout)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestImmediateWithContext_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(20*time.Millisecond, cancel)
+
+	err := ImmediateWithContext(ctx, 5*time.Millisecond, time.Minute, func(context.Context) (bool, error) {
+		return false, nil
+	})
+	assert.ErrorIs(t, err, ErrWaitCanceled)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.NotErrorIs(t, err, ErrWaitTimeout)
+
+	// a context canceled before the first wait
+	err = ImmediateWithContext(ctx, 5*time.Millisecond, time.Minute, func(context.Context) (bool, error) {
+		return false, nil
+	})
+	assert.ErrorIs(t, err, ErrWaitCanceled)
 }
 
 func TestPollImmediateWithContext_Error(t *testing.T) {
@@ -164,3 +182,64 @@ func TestWaitForWithContext_Error(t *testing.T) {
 	assert.Error(t, err)
 	assert.Equal(t, "condition error", err.Error())
 }
+
+func TestBackoffStep(t *testing.T) {
+	b := Backoff{Interval: time.Second, Factor: 2, Cap: 3 * time.Second}
+	assert.Equal(t, time.Second, b.Step(0))
+	assert.Equal(t, 2*time.Second, b.Step(time.Second))
+	assert.Equal(t, 3*time.Second, b.Step(2*time.Second))
+
+	b = Backoff{Interval: time.Second, Jitter: 0.5}
+	for i := 0; i < 10; i++ {
+		d := b.jitter(b.Step(time.Second))
+		assert.GreaterOrEqual(t, d, time.Second)
+		assert.LessOrEqual(t, d, 1500*time.Millisecond)
+	}
+}
+
+func TestPollUntil_ReturnsLastValue(t *testing.T) {
+	var progress []Progress[int]
+	opts := Options[int]{
+		Backoff:    Backoff{Interval: time.Millisecond, Factor: 2, Cap: 4 * time.Millisecond},
+		Timeout:    time.Second,
+		OnProgress: func(p Progress[int]) { progress = append(progress, p) },
+	}
+	n := 0
+	value, err := PollUntil(context.Background(), opts, func(context.Context) (int, bool, error) {
+		n++
+		return n, n == 3, nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, value)
+	assert.Len(t, progress, 2)
+	assert.Equal(t, 2, progress[1].Attempt)
+	assert.Equal(t, 2, progress[1].Value)
+}
+
+func TestPollUntil_TimeoutAndCancel(t *testing.T) {
+	condition := func(context.Context) (string, bool, error) {
+		return "pending", false, nil
+	}
+	opts := Options[string]{Backoff: Backoff{Interval: 10 * time.Millisecond}, Timeout: 50 * time.Millisecond}
+
+	value, err := PollUntil(context.Background(), opts, condition)
+	assert.Equal(t, ErrWaitTimeout, err)
+	assert.Equal(t, "pending", value)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	opts.Timeout = 0
+	_, err = PollUntil(ctx, opts, condition)
+	assert.ErrorIs(t, err, ErrWaitTimeout)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	ctx, cancel = context.WithCancel(context.Background())
+	time.AfterFunc(20*time.Millisecond, cancel)
+	_, err = PollUntil(ctx, opts, condition)
+	assert.ErrorIs(t, err, ErrWaitCanceled)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.NotErrorIs(t, err, ErrWaitTimeout)
+
+	_, err = PollUntil(context.Background(), Options[string]{}, condition)
+	assert.EqualError(t, err, "poll interval must be positive")
+}
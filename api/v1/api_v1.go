@@ -26,17 +26,26 @@ import (
 )
 
 const (
-	namespacePath     = "namespace"
-	exportsPath       = "platform/1/protocols/nfs/exports"
-	quotaPath         = "platform/1/quota/quotas"
-	snapshotsPath     = "platform/1/snapshot/snapshots"
-	zonesPath         = "platform/1/zones"
-	snapshotParentDir = ".snapshot"
-	userPath          = "platform/1/auth/users"
-	rolePath          = "platform/1/auth/roles"
-	roleMemberPath    = "platform/1/auth/roles/%s/members"
-	groupPath         = "platform/1/auth/groups"
-	groupMemberPath   = "platform/1/auth/groups/%s/members"
+	namespacePath        = "namespace"
+	exportsPath          = "platform/1/protocols/nfs/exports"
+	quotaPath            = "platform/1/quota/quotas"
+	snapshotsPath        = "platform/1/snapshot/snapshots"
+	snapshotLocksPath    = "platform/1/snapshot/snapshots/%d/locks"
+	snapshotAliasPath    = "platform/1/snapshot/aliases"
+	snapshotPendingPath  = "platform/1/snapshot/pending"
+	snapshotSettingsPath = "platform/1/snapshot/settings"
+	zonesPath            = "platform/1/zones"
+	snapshotParentDir    = ".snapshot"
+	userPath             = "platform/1/auth/users"
+	rolePath             = "platform/1/auth/roles"
+	roleMemberPath       = "platform/1/auth/roles/%s/members"
+	groupPath            = "platform/1/auth/groups"
+	groupMemberPath      = "platform/1/auth/groups/%s/members"
+	changelistsPath      = "platform/1/snapshot/changelists"
+	changelistLinPath    = "platform/1/snapshot/changelists/%s/lins"
+	jobsPath             = "platform/1/job/jobs"
+	jobEventsPath        = "platform/1/job/events"
+	authAccessPath       = "platform/1/auth/access"
 )
 
 var debug, _ = strconv.ParseBool(os.Getenv("GOISILON_DEBUG"))
@@ -0,0 +1,83 @@
+/*
+Copyright (c) 2025 Dell Inc, or its subsidiaries.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/dell/goisilon/api"
+	"github.com/dell/goisilon/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetIsiUserAccess(t *testing.T) {
+	ctx := context.Background()
+	client := &mocks.Client{}
+
+	name, zone := "alice", "zone1"
+	client.On("Get", ctx, authAccessPath, "USER:alice", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		params := args.Get(3).(api.OrderedValues)
+		assert.Equal(t, "path=%2Fifs%2Fdata&zone=zone1", params.Encode())
+		err := json.Unmarshal([]byte(`{"access":[{
+			"id":"USER:alice",
+			"file":{"file_owner":{"id":"UID:0","name":"root","type":"user"},"file_group":{"id":"GID:0","name":"wheel","type":"group"},"mode":"0755"},
+			"permissions":{"expected":"dir_gen_read,dir_gen_execute","relevant_ace":[
+				{"trustee":{"id":"SID:S-1-1-0","name":"Everyone","type":"wellknown"},"accesstype":"allow","accessrights":["dir_gen_read","dir_gen_execute"]}]}}]}`),
+			args.Get(5))
+		assert.NoError(t, err)
+	}).Once()
+	access, err := GetIsiUserAccess(ctx, client, &name, nil, nil, "/ifs/data", &zone)
+	assert.NoError(t, err)
+	assert.Equal(t, "root", access.File.FileOwner.Name)
+	assert.Equal(t, "Everyone", access.Permissions.RelevantACE[0].Trustee.Name)
+	assert.Equal(t, []string{"dir_gen_read", "dir_gen_execute"}, access.EffectiveRights())
+	assert.True(t, access.HasRights("DIR_GEN_READ"))
+	assert.False(t, access.HasRights("dir_gen_read", "dir_gen_write"))
+
+	sid := "S-1-5-21-1"
+	client.On("Get", ctx, authAccessPath, "SID:S-1-5-21-1", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("not found")).Once()
+	_, err = GetIsiUserAccess(ctx, client, nil, nil, &sid, "/ifs/data", nil)
+	assert.EqualError(t, err, "not found")
+
+	var uid int32 = 2000
+	client.On("Get", ctx, authAccessPath, "UID:2000", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	_, err = GetIsiUserAccess(ctx, client, nil, &uid, nil, "/ifs/data", nil)
+	assert.EqualError(t, err, "no access returned for UID:2000 on /ifs/data")
+
+	_, err = GetIsiUserAccess(ctx, client, nil, nil, nil, "/ifs/data", nil)
+	assert.EqualError(t, err, "user name, uid or sid is required")
+	_, err = GetIsiUserAccess(ctx, client, &name, nil, nil, "", nil)
+	assert.EqualError(t, err, "path is required")
+}
+
+func TestIsiUserAccessHasRights(t *testing.T) {
+	owner := &IsiUserAccess{Permissions: IsiAccessPermissions{Expected: "dir_gen_all"}}
+	assert.True(t, owner.HasRights("dir_gen_read", "dir_gen_write", "file_write", "add_subdir", "delete_child", "std_write_owner"))
+
+	modify := &IsiUserAccess{Permissions: IsiAccessPermissions{Expected: "modify"}}
+	assert.True(t, modify.HasRights("file_gen_write", "std_delete"))
+	assert.False(t, modify.HasRights("std_write_dac"))
+
+	specific := &IsiUserAccess{Permissions: IsiAccessPermissions{Expected: "list,dir_read_attr,dir_read_ext_attr,std_read_dac,std_synchronize,custom"}}
+	assert.True(t, specific.HasRights("dir_gen_read", "custom"))
+	assert.False(t, specific.HasRights("dir_gen_execute"))
+	assert.False(t, specific.HasRights("other"))
+}
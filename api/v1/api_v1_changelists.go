@@ -0,0 +1,106 @@
+/*
+Copyright (c) 2025 Dell Inc, or its subsidiaries.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/dell/goisilon/api"
+)
+
+// GetIsiChangelists queries a list of all snapshot changelists on the cluster
+func GetIsiChangelists(
+	ctx context.Context,
+	client api.Client,
+) ([]*IsiChangelist, error) {
+	// PAPI call: GET https://1.2.3.4:8080/platform/1/snapshot/changelists
+	var changelists []*IsiChangelist
+	var params api.OrderedValues
+	for {
+		var resp IsiChangelistsResp
+		err := client.Get(ctx, changelistsPath, "", params, nil, &resp)
+		if err != nil {
+			return nil, err
+		}
+		changelists = append(changelists, resp.Changelists...)
+		if resp.Resume == "" {
+			break
+		}
+		params = api.OrderedValues{
+			{[]byte("resume"), []byte(resp.Resume)},
+		}
+	}
+	return changelists, nil
+}
+
+// GetIsiChangelist queries an individual snapshot changelist
+func GetIsiChangelist(
+	ctx context.Context,
+	client api.Client,
+	id string,
+) (*IsiChangelist, error) {
+	// PAPI call: GET https://1.2.3.4:8080/platform/1/snapshot/changelists/2_5
+	var resp IsiChangelistsResp
+	err := client.Get(ctx, changelistsPath, id, nil, nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Changelists) == 0 {
+		return nil, fmt.Errorf("changelist %s not found", id)
+	}
+	return resp.Changelists[0], nil
+}
+
+// RemoveIsiChangelist deletes a snapshot changelist
+func RemoveIsiChangelist(
+	ctx context.Context,
+	client api.Client,
+	id string,
+) error {
+	// PAPI call: DELETE https://1.2.3.4:8080/platform/1/snapshot/changelists/2_5
+	return client.Delete(ctx, changelistsPath, id, nil, nil, nil)
+}
+
+// GetIsiChangelistEntries queries one page of the entries of a snapshot changelist,
+// an empty resume token queries the first page
+func GetIsiChangelistEntries(
+	ctx context.Context,
+	client api.Client,
+	id string,
+	limit int,
+	resume string,
+) (*IsiChangelistEntriesResp, error) {
+	// PAPI call: GET https://1.2.3.4:8080/platform/1/snapshot/changelists/2_5/lins?limit=1000
+	//            GET https://1.2.3.4:8080/platform/1/snapshot/changelists/2_5/lins?resume=<resume token>
+	var params api.OrderedValues
+	if resume != "" {
+		params = api.OrderedValues{
+			{[]byte("resume"), []byte(resume)},
+		}
+	} else if limit > 0 {
+		params = api.OrderedValues{
+			{[]byte("limit"), []byte(strconv.Itoa(limit))},
+		}
+	}
+	var resp IsiChangelistEntriesResp
+	err := client.Get(ctx, fmt.Sprintf(changelistLinPath, id), "", params, nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
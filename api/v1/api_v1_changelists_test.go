@@ -0,0 +1,82 @@
+/*
+Copyright (c) 2025 Dell Inc, or its subsidiaries.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/dell/goisilon/api"
+	"github.com/dell/goisilon/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetIsiChangelists(t *testing.T) {
+	ctx := context.Background()
+	client := &mocks.Client{}
+
+	client.On("Get", anyArgs[:6]...).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*IsiChangelistsResp)
+		resp.Changelists = []*IsiChangelist{{ID: "2_5"}}
+		resp.Resume = "resume"
+	}).Once()
+	client.On("Get", anyArgs[:6]...).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*IsiChangelistsResp)
+		resp.Changelists = []*IsiChangelist{{ID: "5_9"}}
+	}).Once()
+	changelists, err := GetIsiChangelists(ctx, client)
+	assert.Nil(t, err)
+	assert.Len(t, changelists, 2)
+
+	client.On("Get", anyArgs[:6]...).Return(errors.New("error")).Once()
+	_, err = GetIsiChangelists(ctx, client)
+	assert.NotNil(t, err)
+}
+
+func TestGetIsiChangelist(t *testing.T) {
+	ctx := context.Background()
+	client := &mocks.Client{}
+
+	client.On("Get", ctx, "platform/1/snapshot/changelists", "2_5", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	_, err := GetIsiChangelist(ctx, client, "2_5")
+	assert.Equal(t, errors.New("changelist 2_5 not found"), err)
+
+	client.On("Delete", ctx, "platform/1/snapshot/changelists", "2_5", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	assert.Nil(t, RemoveIsiChangelist(ctx, client, "2_5"))
+}
+
+func TestGetIsiChangelistEntries(t *testing.T) {
+	ctx := context.Background()
+	client := &mocks.Client{}
+
+	client.On("Get", ctx, "platform/1/snapshot/changelists/2_5/lins", "", api.OrderedValues{{[]byte("limit"), []byte("10")}}, mock.Anything, mock.Anything).
+		Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*IsiChangelistEntriesResp)
+		resp.Entries = []*IsiChangelistEntry{{ID: 1, Path: "/ifs/data/file"}}
+		resp.Resume = "next"
+	}).Once()
+	page, err := GetIsiChangelistEntries(ctx, client, "2_5", 10, "")
+	assert.Nil(t, err)
+	assert.Equal(t, "next", page.Resume)
+
+	client.On("Get", ctx, "platform/1/snapshot/changelists/2_5/lins", "", api.OrderedValues{{[]byte("resume"), []byte("next")}}, mock.Anything, mock.Anything).
+		Return(errors.New("error")).Once()
+	_, err = GetIsiChangelistEntries(ctx, client, "2_5", 10, "next")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,387 @@
+/*
+Copyright (c) 2025 Dell Inc, or its subsidiaries.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dell/goisilon/api"
+)
+
+// IsiFileInfo is the metadata of a namespace file returned with its content
+type IsiFileInfo struct {
+	// Size is the size of the whole file, not only of the requested range
+	Size         int64
+	ETag         string
+	LastModified time.Time
+	ContentType  string
+	// Partial is set if only a range of the file was returned
+	Partial bool
+}
+
+// IsiFileRange selects the bytes of a file to read. A zero Length reads to the end of the file.
+type IsiFileRange struct {
+	Offset int64
+	Length int64
+}
+
+func (r IsiFileRange) header() string {
+	if r.Offset == 0 && r.Length <= 0 {
+		return ""
+	}
+	if r.Length <= 0 {
+		return fmt.Sprintf("bytes=%d-", r.Offset)
+	}
+	return fmt.Sprintf("bytes=%d-%d", r.Offset, r.Offset+r.Length-1)
+}
+
+// namespaceID returns isiPath relative to the namespace, so that no trailing
+// slash is added to the path of a file
+func namespaceID(isiPath string) string {
+	return strings.TrimPrefix(path.Clean("/"+isiPath), "/")
+}
+
+// newIsiFileInfo reads the file metadata from the headers of a namespace response
+func newIsiFileInfo(statusCode int, header http.Header) IsiFileInfo {
+	info := IsiFileInfo{
+		ETag:        header.Get("ETag"),
+		ContentType: header.Get("Content-Type"),
+		Partial:     statusCode == http.StatusPartialContent,
+	}
+	if t, err := http.ParseTime(header.Get("Last-Modified")); err == nil {
+		info.LastModified = t
+	}
+	info.Size, _ = strconv.ParseInt(header.Get("Content-Length"), 10, 64)
+	// Content-Range: bytes 0-99/1234
+	if cr := header.Get("Content-Range"); cr != "" {
+		if i := strings.LastIndexByte(cr, '/'); i >= 0 {
+			if size, err := strconv.ParseInt(cr[i+1:], 10, 64); err == nil {
+				info.Size = size
+			}
+		}
+	}
+	return info
+}
+
+// GetIsiFileContent copies the content of the file at isiPath, or the given
+// range of it, to w. onInfo, if set, is called with the file metadata before
+// the content is copied.
+func GetIsiFileContent(
+	ctx context.Context,
+	client api.Client,
+	isiPath string,
+	byteRange IsiFileRange,
+	w io.Writer,
+	onInfo func(IsiFileInfo),
+) error {
+	// PAPI call: GET https://1.2.3.4:8080/namespace/path/to/file
+	//            Range: bytes=offset-end
+	if byteRange.Offset < 0 {
+		return errors.New("file offset must not be negative")
+	}
+	var headers map[string]string
+	if r := byteRange.header(); r != "" {
+		headers = map[string]string{"Range": r}
+	}
+	resp := &api.StreamResponse{Body: w}
+	if onInfo != nil {
+		resp.OnHeader = func(statusCode int, header http.Header) {
+			onInfo(newIsiFileInfo(statusCode, header))
+		}
+	}
+	return client.Get(ctx, namespacePath, namespaceID(isiPath), nil, headers, resp)
+}
+
+// PutIsiFileContent writes size bytes read from content to the file at
+// isiPath with the given mode, given as octal digits like "0644". Unless
+// overwrite is set, an existing file is not replaced.
+func PutIsiFileContent(
+	ctx context.Context,
+	client api.Client,
+	isiPath string,
+	content io.ReadCloser,
+	size int64,
+	mode string,
+	overwrite bool,
+) error {
+	// PAPI call: PUT https://1.2.3.4:8080/namespace/path/to/file?overwrite=false
+	//            x-isi-ifs-target-type: object
+	//            x-isi-ifs-access-control: mode
+	//            Content-Length: size
+	if size < 0 {
+		return errors.New("file size must not be negative")
+	}
+	var params api.OrderedValues
+	if !overwrite {
+		params = api.NewOrderedValues([][]string{{"overwrite", "false"}})
+	}
+	headers := map[string]string{
+		"x-isi-ifs-target-type": "object",
+		"Content-Length":        strconv.FormatInt(size, 10),
+	}
+	if mode != "" {
+		headers["x-isi-ifs-access-control"] = mode
+	}
+	return client.Put(ctx, namespacePath, namespaceID(isiPath), params, headers, content, nil)
+}
+
+// IsiNamespaceEntryTypeContainer is the type of a directory in the namespace API
+const IsiNamespaceEntryTypeContainer = "container"
+
+// IsiNamespaceDetail is the default set of attributes returned for namespace entries
+var IsiNamespaceDetail = []string{"name", "type", "size", "mode", "owner", "group", "last_modified"}
+
+// IsiNamespaceEntry is a child of a namespace directory, only the requested attributes are set
+type IsiNamespaceEntry struct {
+	Name          string `json:"name"`
+	ContainerPath string `json:"container_path,omitempty"`
+	Type          string `json:"type,omitempty"`
+	Size          int64  `json:"size,omitempty"`
+	Mode          string `json:"mode,omitempty"`
+	Owner         string `json:"owner,omitempty"`
+	Group         string `json:"group,omitempty"`
+	LastModified  string `json:"last_modified,omitempty"`
+}
+
+// IsiNamespaceChildren is a page of the children of a namespace directory
+type IsiNamespaceChildren struct {
+	Children []IsiNamespaceEntry `json:"children"`
+	Resume   string              `json:"resume,omitempty"`
+}
+
+// GetIsiDirectoryChildren returns a page of at most limit children of the
+// directory at isiPath, sorted by name, with the given attributes. The name
+// and type are always returned. Pass the resume token of the previous page to
+// get the next one.
+func GetIsiDirectoryChildren(
+	ctx context.Context,
+	client api.Client,
+	isiPath string,
+	detail []string,
+	limit int,
+	resume string,
+) (*IsiNamespaceChildren, error) {
+	// PAPI call: GET https://1.2.3.4:8080/namespace/path/to/dir/?detail=name,type&limit=1000&sort=name&dir=ASC
+	var params api.OrderedValues
+	if resume != "" {
+		params = api.OrderedValues{{[]byte("resume"), []byte(resume)}}
+	} else {
+		if len(detail) == 0 {
+			detail = IsiNamespaceDetail
+		}
+		d := [][]byte{[]byte("detail"), []byte("name"), []byte("type")}
+		for _, attr := range detail {
+			if attr != "name" && attr != "type" {
+				d = append(d, []byte(attr))
+			}
+		}
+		params = api.OrderedValues{d, {[]byte("sort"), []byte("name")}, {[]byte("dir"), []byte("ASC")}}
+		if limit > 0 {
+			params = append(params, [][]byte{[]byte("limit"), []byte(strconv.Itoa(limit))})
+		}
+	}
+	resp := &IsiNamespaceChildren{}
+	if err := client.Get(ctx, namespacePath, namespaceID(isiPath), params, nil, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetIsiNamespaceEntry returns the attributes of the file or directory at isiPath
+func GetIsiNamespaceEntry(ctx context.Context, client api.Client, isiPath string) (*IsiNamespaceEntry, error) {
+	// PAPI call: GET https://1.2.3.4:8080/namespace/path/to/file?metadata
+	var resp GetIsiVolumeAttributesResp
+	if err := client.Get(ctx, namespacePath, namespaceID(isiPath), metadataQS, nil, &resp); err != nil {
+		return nil, err
+	}
+	entry := &IsiNamespaceEntry{Name: path.Base(path.Clean("/" + isiPath)), ContainerPath: path.Dir(path.Clean("/" + isiPath))}
+	for _, attr := range resp.AttributeMap {
+		switch v := attr.Value.(type) {
+		case string:
+			switch attr.Name {
+			case "type":
+				entry.Type = v
+			case "mode":
+				entry.Mode = v
+			case "owner":
+				entry.Owner = v
+			case "group":
+				entry.Group = v
+			case "last_modified":
+				entry.LastModified = v
+			}
+		case float64:
+			if attr.Name == "size" {
+				entry.Size = int64(v)
+			}
+		}
+	}
+	return entry, nil
+}
+
+// MoveIsiNamespaceEntry moves or renames the file or directory at srcIsiPath
+// to dstIsiPath, which must not exist. No data is copied.
+func MoveIsiNamespaceEntry(ctx context.Context, client api.Client, srcIsiPath, dstIsiPath string) error {
+	// PAPI call: POST https://1.2.3.4:8080/namespace/path/to/source
+	//            x-isi-ifs-set-location: /namespace/path/to/destination
+	return client.Post(ctx, namespacePath, namespaceID(srcIsiPath), nil,
+		map[string]string{
+			"x-isi-ifs-set-location": path.Join("/", GetRealNamespacePathWithIsiPath(dstIsiPath)),
+		},
+		nil, nil)
+}
+
+// DeleteIsiNamespaceEntry deletes the file or directory at isiPath. A
+// directory must be empty unless recursive is set.
+func DeleteIsiNamespaceEntry(ctx context.Context, client api.Client, isiPath string, recursive bool) error {
+	// PAPI call: DELETE https://1.2.3.4:8080/namespace/path/to/entry?recursive=true
+	var params api.OrderedValues
+	if recursive {
+		params = recursiveTrueQS
+	}
+	return client.Delete(ctx, namespacePath, namespaceID(isiPath), params, nil, nil)
+}
+
+// IsiCopyOptions selects how CopyIsiNamespaceEntry handles existing entries and errors
+type IsiCopyOptions struct {
+	// Overwrite replaces existing files at the destination
+	Overwrite bool
+	// Merge copies a directory into an existing destination directory
+	Merge bool
+	// Continue copies the remaining entries after an entry failed to copy
+	Continue bool
+	// Clone clones a file instead of copying its data, Snapshot is the snapshot to clone from
+	Clone    bool
+	Snapshot string
+	// PreserveMode keeps the mode of the copied entries
+	PreserveMode bool
+}
+
+func (o IsiCopyOptions) values() api.OrderedValues {
+	var params api.OrderedValues
+	add := func(key string, set bool) {
+		if set {
+			params = append(params, [][]byte{[]byte(key), []byte("true")})
+		}
+	}
+	add("overwrite", o.Overwrite)
+	add("merge", o.Merge)
+	add("continue", o.Continue)
+	add("clone", o.Clone)
+	if o.Clone && o.Snapshot != "" {
+		params = append(params, [][]byte{[]byte("snapshot"), []byte(o.Snapshot)})
+	}
+	return params
+}
+
+// CopyIsiNamespaceEntry copies the file or directory at srcIsiPath to
+// dstIsiPath on the cluster. Entries that could not be copied are reported in
+// the CopyErrors of the response.
+func CopyIsiNamespaceEntry(
+	ctx context.Context,
+	client api.Client,
+	srcIsiPath, dstIsiPath string,
+	opts IsiCopyOptions,
+) (resp *CopyIsiVolumesResp, err error) {
+	// PAPI call: PUT https://1.2.3.4:8080/namespace/path/to/destination?overwrite=true&merge=true&continue=true
+	//            x-isi-ifs-copy-source: /namespace/path/to/source
+	headers := map[string]string{
+		"x-isi-ifs-copy-source": path.Join("/", GetRealNamespacePathWithIsiPath(srcIsiPath)),
+	}
+	if opts.PreserveMode {
+		headers["x-isi-ifs-mode-mask"] = "preserve"
+	}
+	err = client.Put(ctx, namespacePath, namespaceID(dstIsiPath), opts.values(), headers, nil, &resp)
+	return resp, err
+}
+
+// IsiUserMetadataNamespace is the namespace of user-defined metadata attributes
+const IsiUserMetadataNamespace = "user"
+
+// IsiMetadataAttr is a system or user-defined metadata attribute of a namespace entry
+type IsiMetadataAttr struct {
+	Name  string      `json:"name"`
+	Value interface{} `json:"value,omitempty"`
+	// Namespace is "user" for user-defined metadata and empty for system attributes
+	Namespace string `json:"namespace,omitempty"`
+	// Op is "update" or "delete" when modifying the metadata
+	Op string `json:"op,omitempty"`
+}
+
+// IsiMetadataUpdate modifies the user-defined metadata of a namespace entry.
+// Action "update" applies the op of every attribute, "replace" replaces all
+// user-defined metadata with the attributes.
+type IsiMetadataUpdate struct {
+	Action string            `json:"action"`
+	Attrs  []IsiMetadataAttr `json:"attrs"`
+}
+
+// IsiProtection holds the protection attributes of a namespace entry
+type IsiProtection struct {
+	AccessPattern            string `json:"access_pattern,omitempty"`
+	Level                    string `json:"level,omitempty"`
+	Coalesced                bool   `json:"coalesced"`
+	Endurant                 bool   `json:"endurant"`
+	Performance              int    `json:"performance,omitempty"`
+	DataDiskPoolPolicyID     int    `json:"data_disk_pool_policy_id,omitempty"`
+	MetadataDiskPoolPolicyID int    `json:"metadata_disk_pool_policy_id,omitempty"`
+	ManuallyManageAccess     bool   `json:"manually_manage_access"`
+	ManuallyManageProtection bool   `json:"manually_manage_protection"`
+}
+
+var protectionQS = api.OrderedValues{{[]byte("protection")}}
+
+// GetIsiMetadata returns the system and user-defined metadata of the file or directory at isiPath
+func GetIsiMetadata(ctx context.Context, client api.Client, isiPath string) ([]IsiMetadataAttr, error) {
+	// PAPI call: GET https://1.2.3.4:8080/namespace/path/to/file?metadata
+	var resp GetIsiVolumeAttributesResp
+	if err := client.Get(ctx, namespacePath, namespaceID(isiPath), metadataQS, nil, &resp); err != nil {
+		return nil, err
+	}
+	attrs := make([]IsiMetadataAttr, 0, len(resp.AttributeMap))
+	for _, attr := range resp.AttributeMap {
+		attrs = append(attrs, IsiMetadataAttr{Name: attr.Name, Value: attr.Value, Namespace: attr.Namespace})
+	}
+	return attrs, nil
+}
+
+// UpdateIsiMetadata modifies the user-defined metadata of the file or directory at isiPath
+func UpdateIsiMetadata(ctx context.Context, client api.Client, isiPath string, update *IsiMetadataUpdate) error {
+	// PAPI call: PUT https://1.2.3.4:8080/namespace/path/to/file?metadata
+	//            {"action": "update", "attrs": [{"name": "key", "value": "value", "namespace": "user", "op": "update"}]}
+	if update == nil || (update.Action != "update" && update.Action != "replace") {
+		return errors.New("metadata update action must be update or replace")
+	}
+	return client.Put(ctx, namespacePath, namespaceID(isiPath), metadataQS, nil, update, nil)
+}
+
+// GetIsiProtection returns the protection attributes of the file or directory at isiPath
+func GetIsiProtection(ctx context.Context, client api.Client, isiPath string) (*IsiProtection, error) {
+	// PAPI call: GET https://1.2.3.4:8080/namespace/path/to/file?protection
+	var resp IsiProtection
+	if err := client.Get(ctx, namespacePath, namespaceID(isiPath), protectionQS, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -0,0 +1,188 @@
+/*
+Copyright (c) 2025 Dell Inc, or its subsidiaries.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dell/goisilon/api"
+	"github.com/dell/goisilon/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetIsiFileContent(t *testing.T) {
+	ctx := context.Background()
+	client := &mocks.Client{}
+
+	modified := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	client.On("Get", ctx, "namespace", "ifs/data/file.txt", mock.Anything, map[string]string{"Range": "bytes=2-5"}, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*api.StreamResponse)
+		resp.OnHeader(http.StatusPartialContent, http.Header{
+			"Etag":          {`"abc"`},
+			"Last-Modified": {modified.Format(http.TimeFormat)},
+			"Content-Range": {"bytes 2-5/10"},
+		})
+		_, _ = io.WriteString(resp.Body, "cdef")
+	}).Once()
+
+	var buf bytes.Buffer
+	var info IsiFileInfo
+	err := GetIsiFileContent(ctx, client, "/ifs/data/file.txt", IsiFileRange{Offset: 2, Length: 4}, &buf, func(i IsiFileInfo) { info = i })
+	assert.NoError(t, err)
+	assert.Equal(t, "cdef", buf.String())
+	assert.Equal(t, IsiFileInfo{Size: 10, ETag: `"abc"`, LastModified: modified, Partial: true}, info)
+
+	err = GetIsiFileContent(ctx, client, "/ifs/data/file.txt", IsiFileRange{Offset: -1}, &buf, nil)
+	assert.EqualError(t, err, "file offset must not be negative")
+
+	assert.Equal(t, "", IsiFileRange{}.header())
+	assert.Equal(t, "bytes=3-", IsiFileRange{Offset: 3}.header())
+}
+
+func TestPutIsiFileContent(t *testing.T) {
+	ctx := context.Background()
+	client := &mocks.Client{}
+
+	headers := map[string]string{
+		"x-isi-ifs-target-type":    "object",
+		"x-isi-ifs-access-control": "0600",
+		"Content-Length":           "5",
+	}
+	client.On("Put", ctx, "namespace", "ifs/data/file.txt", api.NewOrderedValues([][]string{{"overwrite", "false"}}), headers, mock.Anything, nil).Return(nil).Once()
+	err := PutIsiFileContent(ctx, client, "/ifs/data/file.txt", io.NopCloser(strings.NewReader("hello")), 5, "0600", false)
+	assert.NoError(t, err)
+
+	err = PutIsiFileContent(ctx, client, "/ifs/data/file.txt", nil, -1, "", true)
+	assert.EqualError(t, err, "file size must not be negative")
+}
+
+func TestGetIsiDirectoryChildren(t *testing.T) {
+	ctx := context.Background()
+	client := &mocks.Client{}
+
+	client.On("Get", ctx, "namespace", "ifs/data", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		params := args.Get(3).(api.OrderedValues)
+		assert.Equal(t, "detail=name,type,size&sort=name&dir=ASC&limit=10", params.Encode())
+		args.Get(5).(*IsiNamespaceChildren).Resume = "next"
+	}).Once()
+	page, err := GetIsiDirectoryChildren(ctx, client, "/ifs/data/", []string{"name", "size"}, 10, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "next", page.Resume)
+
+	client.On("Get", ctx, "namespace", "ifs/data", api.OrderedValues{{[]byte("resume"), []byte("next")}}, mock.Anything, mock.Anything).Return(errors.New("error")).Once()
+	_, err = GetIsiDirectoryChildren(ctx, client, "/ifs/data", nil, 10, "next")
+	assert.EqualError(t, err, "error")
+}
+
+func TestGetIsiNamespaceEntry(t *testing.T) {
+	ctx := context.Background()
+	client := &mocks.Client{}
+
+	client.On("Get", ctx, "namespace", "ifs/data/file.txt", metadataQS, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*GetIsiVolumeAttributesResp)
+		err := json.Unmarshal([]byte(`{"attrs":[
+			{"name":"type","value":"object"},{"name":"size","value":42},{"name":"mode","value":"0640"},
+			{"name":"owner","value":"root"},{"name":"last_modified","value":"Thu, 02 Jan 2025 03:04:05 GMT"}]}`), resp)
+		assert.NoError(t, err)
+	}).Once()
+	entry, err := GetIsiNamespaceEntry(ctx, client, "/ifs/data/file.txt")
+	assert.NoError(t, err)
+	assert.Equal(t, &IsiNamespaceEntry{
+		Name: "file.txt", ContainerPath: "/ifs/data", Type: "object", Size: 42, Mode: "0640",
+		Owner: "root", LastModified: "Thu, 02 Jan 2025 03:04:05 GMT",
+	}, entry)
+
+	client.On("Get", ctx, "namespace", "ifs/missing", metadataQS, mock.Anything, mock.Anything).Return(errors.New("not found")).Once()
+	_, err = GetIsiNamespaceEntry(ctx, client, "/ifs/missing")
+	assert.EqualError(t, err, "not found")
+}
+
+func TestMoveIsiNamespaceEntry(t *testing.T) {
+	ctx := context.Background()
+	client := &mocks.Client{}
+
+	client.On("Post", ctx, "namespace", "ifs/a/vol", api.OrderedValues(nil),
+		map[string]string{"x-isi-ifs-set-location": "/namespace/ifs/b/vol"}, nil, nil).Return(nil).Once()
+	assert.NoError(t, MoveIsiNamespaceEntry(ctx, client, "/ifs/a/vol", "/ifs/b/vol"))
+}
+
+func TestCopyIsiNamespaceEntry(t *testing.T) {
+	ctx := context.Background()
+	client := &mocks.Client{}
+
+	client.On("Put", ctx, "namespace", "ifs/b/vol", mock.Anything, mock.Anything, nil, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		params := args.Get(3).(api.OrderedValues)
+		assert.Equal(t, "merge=true&continue=true", params.Encode())
+		headers := args.Get(4).(map[string]string)
+		assert.Equal(t, "/namespace/ifs/a/vol", headers["x-isi-ifs-copy-source"])
+		assert.Equal(t, "preserve", headers["x-isi-ifs-mode-mask"])
+		resp := args.Get(6).(**CopyIsiVolumesResp)
+		*resp = &CopyIsiVolumesResp{CopyErrors: []CopyError{{Source: "/ifs/a/vol/f", Message: "denied"}}}
+	}).Once()
+	resp, err := CopyIsiNamespaceEntry(ctx, client, "/ifs/a/vol", "/ifs/b/vol", IsiCopyOptions{Merge: true, Continue: true, PreserveMode: true})
+	assert.NoError(t, err)
+	assert.Len(t, resp.CopyErrors, 1)
+
+	params := IsiCopyOptions{Clone: true, Snapshot: "snap"}.values()
+	assert.Equal(t, "clone=true&snapshot=snap", params.Encode())
+}
+
+func TestGetIsiMetadata(t *testing.T) {
+	ctx := context.Background()
+	client := &mocks.Client{}
+
+	client.On("Get", ctx, "namespace", "ifs/data/vol", metadataQS, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*GetIsiVolumeAttributesResp)
+		err := json.Unmarshal([]byte(`{"attrs":[{"name":"size","value":42},{"name":"tier","value":"gold","namespace":"user"}]}`), resp)
+		assert.NoError(t, err)
+	}).Once()
+	attrs, err := GetIsiMetadata(ctx, client, "/ifs/data/vol")
+	assert.NoError(t, err)
+	assert.Equal(t, []IsiMetadataAttr{{Name: "size", Value: float64(42)}, {Name: "tier", Value: "gold", Namespace: "user"}}, attrs)
+}
+
+func TestUpdateIsiMetadata(t *testing.T) {
+	ctx := context.Background()
+	client := &mocks.Client{}
+
+	update := &IsiMetadataUpdate{Action: "update", Attrs: []IsiMetadataAttr{{Name: "k", Value: "v", Namespace: "user", Op: "update"}}}
+	client.On("Put", ctx, "namespace", "ifs/data/vol", metadataQS, mock.Anything, update, nil).Return(nil).Once()
+	assert.NoError(t, UpdateIsiMetadata(ctx, client, "/ifs/data/vol", update))
+
+	assert.EqualError(t, UpdateIsiMetadata(ctx, client, "/ifs/data/vol", &IsiMetadataUpdate{Action: "merge"}),
+		"metadata update action must be update or replace")
+}
+
+func TestDeleteIsiNamespaceEntry(t *testing.T) {
+	ctx := context.Background()
+	client := &mocks.Client{}
+
+	client.On("Delete", ctx, "namespace", "ifs/data/vol", recursiveTrueQS, mock.Anything, nil).Return(nil).Once()
+	assert.NoError(t, DeleteIsiNamespaceEntry(ctx, client, "/ifs/data/vol", true))
+
+	client.On("Delete", ctx, "namespace", "ifs/data/file", api.OrderedValues(nil), mock.Anything, nil).Return(errors.New("error")).Once()
+	assert.EqualError(t, DeleteIsiNamespaceEntry(ctx, client, "/ifs/data/file", false), "error")
+}
@@ -0,0 +1,107 @@
+/*
+Copyright (c) 2025 Dell Inc, or its subsidiaries.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/dell/goisilon/api"
+)
+
+// StartIsiJob queues a new job engine job on the cluster and returns its ID
+func StartIsiJob(
+	ctx context.Context,
+	client api.Client,
+	req *IsiJobReq,
+) (int64, error) {
+	// PAPI call: POST https://1.2.3.4:8080/platform/1/job/jobs
+	//            Content-Type: application/json
+	//            {type: "SnapRevert", snaprevert_params: {snapid: 123}}
+	if req == nil || req.Type == "" {
+		return 0, errors.New("no job type set")
+	}
+	var resp isiStartJobResp
+	err := client.Post(ctx, jobsPath, "", nil, nil, req, &resp)
+	if err != nil {
+		return 0, err
+	}
+	return resp.ID, nil
+}
+
+// GetIsiJob queries an individual job engine job on the cluster
+func GetIsiJob(
+	ctx context.Context,
+	client api.Client,
+	id int64,
+) (*IsiJob, error) {
+	// PAPI call: GET https://1.2.3.4:8080/platform/1/job/jobs/123
+	var resp IsiJobsResp
+	err := client.Get(ctx, jobsPath, strconv.FormatInt(id, 10), nil, nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Jobs) == 0 {
+		return nil, fmt.Errorf("job %d not found", id)
+	}
+	return resp.Jobs[0], nil
+}
+
+// UpdateIsiJobState pauses, resumes or cancels a job engine job
+func UpdateIsiJobState(
+	ctx context.Context,
+	client api.Client,
+	id int64,
+	state string,
+) error {
+	// PAPI call: PUT https://1.2.3.4:8080/platform/1/job/jobs/123
+	//            Content-Type: application/json
+	//            {state: "paused"}
+	if state == "" {
+		return errors.New("no job state set")
+	}
+	return client.Put(ctx, jobsPath, strconv.FormatInt(id, 10), nil, nil, &isiUpdateJobReq{State: state}, nil)
+}
+
+// GetIsiJobEvents queries the events recorded for a job engine job, oldest first
+func GetIsiJobEvents(
+	ctx context.Context,
+	client api.Client,
+	id int64,
+) ([]*IsiJobEvent, error) {
+	// PAPI call: GET https://1.2.3.4:8080/platform/1/job/events?job_id=123
+	var events []*IsiJobEvent
+	params := api.OrderedValues{
+		{[]byte("job_id"), []byte(strconv.FormatInt(id, 10))},
+	}
+	for {
+		var resp IsiJobEventsResp
+		err := client.Get(ctx, jobEventsPath, "", params, nil, &resp)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, resp.Events...)
+		if resp.Resume == "" {
+			break
+		}
+		params = api.OrderedValues{
+			{[]byte("resume"), []byte(resp.Resume)},
+		}
+	}
+	return events, nil
+}
@@ -0,0 +1,102 @@
+/*
+Copyright (c) 2025 Dell Inc, or its subsidiaries.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/dell/goisilon/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestStartIsiJob(t *testing.T) {
+	ctx := context.Background()
+	client := &mocks.Client{}
+
+	_, err := StartIsiJob(ctx, client, nil)
+	assert.Equal(t, errors.New("no job type set"), err)
+
+	client.On("Post", ctx, "platform/1/job/jobs", "", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(6).(*isiStartJobResp)
+		resp.ID = 42
+	}).Once()
+	id, err := StartIsiJob(ctx, client, &IsiJobReq{Type: "SnapRevert", SnaprevertParams: &IsiJobSnaprevertParams{Snapid: 1}})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(42), id)
+
+	client.On("Post", anyArgs...).Return(errors.New("error")).Once()
+	_, err = StartIsiJob(ctx, client, &IsiJobReq{Type: "SnapRevert"})
+	assert.NotNil(t, err)
+}
+
+func TestGetIsiJob(t *testing.T) {
+	ctx := context.Background()
+	client := &mocks.Client{}
+
+	client.On("Get", ctx, "platform/1/job/jobs", "42", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	_, err := GetIsiJob(ctx, client, 42)
+	assert.Equal(t, errors.New("job 42 not found"), err)
+
+	client.On("Get", anyArgs[:6]...).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*IsiJobsResp)
+		resp.Jobs = []*IsiJob{{ID: 42, State: "running"}}
+	}).Once()
+	job, err := GetIsiJob(ctx, client, 42)
+	assert.Nil(t, err)
+	assert.Equal(t, "running", job.State)
+
+	client.On("Get", anyArgs[:6]...).Return(errors.New("error")).Once()
+	_, err = GetIsiJob(ctx, client, 42)
+	assert.NotNil(t, err)
+}
+
+func TestUpdateIsiJobState(t *testing.T) {
+	ctx := context.Background()
+	client := &mocks.Client{}
+
+	err := UpdateIsiJobState(ctx, client, 42, "")
+	assert.Equal(t, errors.New("no job state set"), err)
+
+	client.On("Put", ctx, "platform/1/job/jobs", "42", mock.Anything, mock.Anything, &isiUpdateJobReq{State: "paused"}, mock.Anything).Return(nil).Once()
+	err = UpdateIsiJobState(ctx, client, 42, "paused")
+	assert.Nil(t, err)
+}
+
+func TestGetIsiJobEvents(t *testing.T) {
+	ctx := context.Background()
+	client := &mocks.Client{}
+
+	client.On("Get", anyArgs[:6]...).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*IsiJobEventsResp)
+		resp.Events = []*IsiJobEvent{{ID: 1, JobID: 42, Key: "Running"}}
+		resp.Resume = "resume"
+	}).Once()
+	client.On("Get", anyArgs[:6]...).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*IsiJobEventsResp)
+		resp.Events = []*IsiJobEvent{{ID: 2, JobID: 42, Key: "Succeeded"}}
+	}).Once()
+	events, err := GetIsiJobEvents(ctx, client, 42)
+	assert.Nil(t, err)
+	assert.Len(t, events, 2)
+
+	client.On("Get", anyArgs[:6]...).Return(errors.New("error")).Once()
+	_, err = GetIsiJobEvents(ctx, client, 42)
+	assert.NotNil(t, err)
+}
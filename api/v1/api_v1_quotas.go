@@ -93,6 +93,34 @@ func GetIsiQuotaWithResume(
 	return &quotaResp, nil
 }
 
+// GetIsiQuotasByPath queries all quotas of the given type on a path
+func GetIsiQuotasByPath(
+	ctx context.Context,
+	client api.Client,
+	path, quotaType string,
+) ([]*IsiQuota, error) {
+	// PAPI call: GET https://1.2.3.4:8080/platform/1/quota/quotas?path=/path/to/volume&type=directory
+	var quotas []*IsiQuota
+	params := api.OrderedValues{}
+	params.StringAdd("path", path)
+	params.StringAdd("type", quotaType)
+	for {
+		var resp IsiQuotaListRespResume
+		err := client.Get(ctx, quotaPath, "", params, nil, &resp)
+		if err != nil {
+			return nil, err
+		}
+		quotas = append(quotas, resp.Quotas...)
+		if resp.Resume == "" {
+			break
+		}
+		params = api.OrderedValues{
+			{[]byte("resume"), []byte(resp.Resume)},
+		}
+	}
+	return quotas, nil
+}
+
 // GetIsiQuotaByID get the Quota instance by ID
 func GetIsiQuotaByID(
 	ctx context.Context,
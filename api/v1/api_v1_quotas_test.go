@@ -21,6 +21,7 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/dell/goisilon/api"
 	"github.com/dell/goisilon/mocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -121,6 +122,31 @@ func TestGetIsiQuotaWithResume(t *testing.T) {
 	assert.Equal(t, nil, err)
 }
 
+func TestGetIsiQuotasByPath(t *testing.T) {
+	ctx := context.Background()
+	client := &mocks.Client{}
+
+	client.On("Get", anyArgs...).Return(errors.New("error")).Once()
+	_, err := GetIsiQuotasByPath(ctx, client, "/ifs/data", "directory")
+	assert.Equal(t, errors.New("error"), err)
+
+	client.On("Get", ctx, "platform/1/quota/quotas", "", mock.MatchedBy(func(params api.OrderedValues) bool {
+		return params.String() == "path=%2Fifs%2Fdata&type=directory"
+	}), mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*IsiQuotaListRespResume)
+		resp.Quotas = []*IsiQuota{{ID: "a", Path: "/ifs/data"}}
+		resp.Resume = "next"
+	}).Once()
+	client.On("Get", ctx, "platform/1/quota/quotas", "", api.OrderedValues{{[]byte("resume"), []byte("next")}}, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*IsiQuotaListRespResume)
+		resp.Quotas = []*IsiQuota{{ID: "b", Path: "/ifs/data"}}
+	}).Once()
+	quotas, err := GetIsiQuotasByPath(ctx, client, "/ifs/data", "directory")
+	assert.NoError(t, err)
+	assert.Len(t, quotas, 2)
+	assert.Equal(t, "b", quotas[1].ID)
+}
+
 func TestGetIsiQuotaByID(t *testing.T) {
 	ctx := context.Background()
 	client := &mocks.Client{}
@@ -20,6 +20,7 @@ import (
 	"errors"
 	"fmt"
 	"path"
+	"strconv"
 
 	"github.com/dell/goisilon/api"
 )
@@ -212,3 +213,271 @@ func GetIsiSnapshotFolderWithSize(
 
 	return resp, err
 }
+
+// UpdateIsiSnapshot modifies the name, expiry or alias of a snapshot
+func UpdateIsiSnapshot(
+	ctx context.Context,
+	client api.Client,
+	id int64,
+	req *IsiUpdateSnapshotReq,
+) error {
+	// PAPI call: PUT https://1.2.3.4:8080/platform/1/snapshot/snapshots/123
+	//            Content-Type: application/json
+	//            {name: "new_name", expires: 1700000000, alias: "alias_name"}
+	if req == nil {
+		return errors.New("no snapshot update set")
+	}
+	snapshotURL := fmt.Sprintf("%s/%d", snapshotsPath, id)
+	return client.Put(ctx, snapshotURL, "", nil, nil, req, nil)
+}
+
+// CreateIsiSnapshotLock places a lock on a snapshot so that it cannot be deleted
+func CreateIsiSnapshotLock(
+	ctx context.Context,
+	client api.Client,
+	snapshotID int64,
+	req *IsiSnapshotLockReq,
+) (int64, error) {
+	// PAPI call: POST https://1.2.3.4:8080/platform/1/snapshot/snapshots/123/locks
+	//            Content-Type: application/json
+	//            {comment: "backup in progress", expires: 1700000000}
+	if req == nil {
+		req = &IsiSnapshotLockReq{}
+	}
+	var resp isiCreateSnapshotObjectResp
+	err := client.Post(ctx, fmt.Sprintf(snapshotLocksPath, snapshotID), "", nil, nil, req, &resp)
+	if err != nil {
+		return 0, err
+	}
+	return resp.ID, nil
+}
+
+// GetIsiSnapshotLocks queries all locks held on a snapshot
+func GetIsiSnapshotLocks(
+	ctx context.Context,
+	client api.Client,
+	snapshotID int64,
+) ([]*IsiSnapshotLock, error) {
+	// PAPI call: GET https://1.2.3.4:8080/platform/1/snapshot/snapshots/123/locks
+	var locks []*IsiSnapshotLock
+	var params api.OrderedValues
+	for {
+		var resp IsiSnapshotLocksResp
+		err := client.Get(ctx, fmt.Sprintf(snapshotLocksPath, snapshotID), "", params, nil, &resp)
+		if err != nil {
+			return nil, err
+		}
+		locks = append(locks, resp.Locks...)
+		if resp.Resume == "" {
+			break
+		}
+		params = api.OrderedValues{
+			{[]byte("resume"), []byte(resp.Resume)},
+		}
+	}
+	return locks, nil
+}
+
+// GetIsiSnapshotLock queries an individual lock held on a snapshot
+func GetIsiSnapshotLock(
+	ctx context.Context,
+	client api.Client,
+	snapshotID, lockID int64,
+) (*IsiSnapshotLock, error) {
+	// PAPI call: GET https://1.2.3.4:8080/platform/1/snapshot/snapshots/123/locks/1
+	var resp IsiSnapshotLocksResp
+	err := client.Get(ctx, fmt.Sprintf(snapshotLocksPath, snapshotID), strconv.FormatInt(lockID, 10), nil, nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Locks) == 0 {
+		return nil, fmt.Errorf("lock %d not found on snapshot %d", lockID, snapshotID)
+	}
+	return resp.Locks[0], nil
+}
+
+// UpdateIsiSnapshotLock modifies the expiry of a lock held on a snapshot
+func UpdateIsiSnapshotLock(
+	ctx context.Context,
+	client api.Client,
+	snapshotID, lockID int64,
+	req *IsiSnapshotLockReq,
+) error {
+	// PAPI call: PUT https://1.2.3.4:8080/platform/1/snapshot/snapshots/123/locks/1
+	//            Content-Type: application/json
+	//            {expires: 1700000000}
+	if req == nil {
+		return errors.New("no snapshot lock update set")
+	}
+	return client.Put(ctx, fmt.Sprintf(snapshotLocksPath, snapshotID), strconv.FormatInt(lockID, 10), nil, nil, req, nil)
+}
+
+// RemoveIsiSnapshotLock releases a lock held on a snapshot
+func RemoveIsiSnapshotLock(
+	ctx context.Context,
+	client api.Client,
+	snapshotID, lockID int64,
+) error {
+	// PAPI call: DELETE https://1.2.3.4:8080/platform/1/snapshot/snapshots/123/locks/1
+	return client.Delete(ctx, fmt.Sprintf(snapshotLocksPath, snapshotID), strconv.FormatInt(lockID, 10), nil, nil, nil)
+}
+
+// CreateIsiSnapshotAlias creates an alias pointing at a snapshot
+func CreateIsiSnapshotAlias(
+	ctx context.Context,
+	client api.Client,
+	name, target string,
+) (int64, error) {
+	// PAPI call: POST https://1.2.3.4:8080/platform/1/snapshot/aliases
+	//            Content-Type: application/json
+	//            {name: "alias_name", target: "snapshot_name"}
+	if name == "" {
+		return 0, errors.New("no alias name set")
+	}
+	if target == "" {
+		return 0, errors.New("no alias target set")
+	}
+	var resp isiCreateSnapshotObjectResp
+	err := client.Post(ctx, snapshotAliasPath, "", nil, nil, &IsiSnapshotAliasReq{Name: name, Target: target}, &resp)
+	if err != nil {
+		return 0, err
+	}
+	return resp.ID, nil
+}
+
+// GetIsiSnapshotAliases queries a list of all snapshot aliases on the cluster
+func GetIsiSnapshotAliases(
+	ctx context.Context,
+	client api.Client,
+) ([]*IsiSnapshotAlias, error) {
+	// PAPI call: GET https://1.2.3.4:8080/platform/1/snapshot/aliases
+	var aliases []*IsiSnapshotAlias
+	var params api.OrderedValues
+	for {
+		var resp IsiSnapshotAliasesResp
+		err := client.Get(ctx, snapshotAliasPath, "", params, nil, &resp)
+		if err != nil {
+			return nil, err
+		}
+		aliases = append(aliases, resp.Aliases...)
+		if resp.Resume == "" {
+			break
+		}
+		params = api.OrderedValues{
+			{[]byte("resume"), []byte(resp.Resume)},
+		}
+	}
+	return aliases, nil
+}
+
+// GetIsiSnapshotAlias queries an individual snapshot alias
+// param identity string: alias id or name
+func GetIsiSnapshotAlias(
+	ctx context.Context,
+	client api.Client,
+	identity string,
+) (*IsiSnapshotAlias, error) {
+	// PAPI call: GET https://1.2.3.4:8080/platform/1/snapshot/aliases/id|name
+	var resp IsiSnapshotAliasesResp
+	err := client.Get(ctx, snapshotAliasPath, identity, nil, nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Aliases) == 0 {
+		return nil, fmt.Errorf("snapshot alias %s not found", identity)
+	}
+	return resp.Aliases[0], nil
+}
+
+// UpdateIsiSnapshotAlias renames a snapshot alias or points it at a different snapshot
+// param identity string: alias id or name
+func UpdateIsiSnapshotAlias(
+	ctx context.Context,
+	client api.Client,
+	identity string,
+	req *IsiSnapshotAliasReq,
+) error {
+	// PAPI call: PUT https://1.2.3.4:8080/platform/1/snapshot/aliases/id|name
+	//            Content-Type: application/json
+	//            {name: "new_alias_name", target: "snapshot_name"}
+	if req == nil {
+		return errors.New("no snapshot alias update set")
+	}
+	return client.Put(ctx, snapshotAliasPath, identity, nil, nil, req, nil)
+}
+
+// RemoveIsiSnapshotAlias deletes a snapshot alias
+// param identity string: alias id or name
+func RemoveIsiSnapshotAlias(
+	ctx context.Context,
+	client api.Client,
+	identity string,
+) error {
+	// PAPI call: DELETE https://1.2.3.4:8080/platform/1/snapshot/aliases/id|name
+	return client.Delete(ctx, snapshotAliasPath, identity, nil, nil, nil)
+}
+
+// GetIsiPendingSnapshots queries the snapshots schedules will create between begin and end,
+// given as unix timestamps. A zero begin or end leaves that bound to the cluster default.
+func GetIsiPendingSnapshots(
+	ctx context.Context,
+	client api.Client,
+	begin, end int64,
+) ([]*IsiPendingSnapshot, error) {
+	// PAPI call: GET https://1.2.3.4:8080/platform/1/snapshot/pending?begin=1700000000&end=1700086400
+	var params api.OrderedValues
+	if begin != 0 {
+		params = append(params, [][]byte{[]byte("begin"), []byte(strconv.FormatInt(begin, 10))})
+	}
+	if end != 0 {
+		params = append(params, [][]byte{[]byte("end"), []byte(strconv.FormatInt(end, 10))})
+	}
+	var pending []*IsiPendingSnapshot
+	for {
+		var resp IsiPendingSnapshotsResp
+		err := client.Get(ctx, snapshotPendingPath, "", params, nil, &resp)
+		if err != nil {
+			return nil, err
+		}
+		pending = append(pending, resp.Pending...)
+		if resp.Resume == "" {
+			break
+		}
+		params = api.OrderedValues{
+			{[]byte("resume"), []byte(resp.Resume)},
+		}
+	}
+	return pending, nil
+}
+
+// GetIsiSnapshotSettings queries the cluster wide snapshot settings
+func GetIsiSnapshotSettings(
+	ctx context.Context,
+	client api.Client,
+) (*IsiSnapshotSettings, error) {
+	// PAPI call: GET https://1.2.3.4:8080/platform/1/snapshot/settings
+	var resp IsiSnapshotSettingsResp
+	err := client.Get(ctx, snapshotSettingsPath, "", nil, nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Settings == nil {
+		return nil, errors.New("no snapshot settings returned")
+	}
+	return resp.Settings, nil
+}
+
+// UpdateIsiSnapshotSettings modifies the cluster wide snapshot settings
+func UpdateIsiSnapshotSettings(
+	ctx context.Context,
+	client api.Client,
+	req *IsiSnapshotSettingsReq,
+) error {
+	// PAPI call: PUT https://1.2.3.4:8080/platform/1/snapshot/settings
+	//            Content-Type: application/json
+	//            {nfs_root_accessible: true, nfs_subdir_accessible: true}
+	if req == nil {
+		return errors.New("no snapshot settings update set")
+	}
+	return client.Put(ctx, snapshotSettingsPath, "", nil, nil, req, nil)
+}
@@ -21,6 +21,7 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/dell/goisilon/api"
 	"github.com/dell/goisilon/mocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -153,3 +154,190 @@ func TestGetIsiSnapshotFolderWithSize(t *testing.T) {
 	_, err := GetIsiSnapshotFolderWithSize(ctx, client, "", "", "", "")
 	assert.Equal(t, nil, err)
 }
+
+func TestUpdateIsiSnapshot(t *testing.T) {
+	ctx := context.Background()
+	client := &mocks.Client{}
+
+	err := UpdateIsiSnapshot(ctx, client, 1, nil)
+	assert.Equal(t, errors.New("no snapshot update set"), err)
+
+	client.On("Put", ctx, "platform/1/snapshot/snapshots/1", "", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	err = UpdateIsiSnapshot(ctx, client, 1, &IsiUpdateSnapshotReq{Name: "renamed"})
+	assert.Nil(t, err)
+}
+
+func TestCreateIsiSnapshotLock(t *testing.T) {
+	ctx := context.Background()
+	client := &mocks.Client{}
+
+	client.On("Post", ctx, "platform/1/snapshot/snapshots/1/locks", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(6).(*isiCreateSnapshotObjectResp)
+		resp.ID = 3
+	}).Once()
+	id, err := CreateIsiSnapshotLock(ctx, client, 1, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), id)
+
+	client.On("Post", anyArgs...).Return(errors.New("error")).Once()
+	_, err = CreateIsiSnapshotLock(ctx, client, 1, &IsiSnapshotLockReq{Comment: "backup"})
+	assert.NotNil(t, err)
+}
+
+func TestGetIsiSnapshotLocks(t *testing.T) {
+	ctx := context.Background()
+	client := &mocks.Client{}
+
+	client.On("Get", anyArgs[:6]...).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*IsiSnapshotLocksResp)
+		resp.Locks = []*IsiSnapshotLock{{ID: 1}}
+		resp.Resume = "resume"
+	}).Once()
+	client.On("Get", anyArgs[:6]...).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*IsiSnapshotLocksResp)
+		resp.Locks = []*IsiSnapshotLock{{ID: 2}}
+	}).Once()
+	locks, err := GetIsiSnapshotLocks(ctx, client, 1)
+	assert.Nil(t, err)
+	assert.Len(t, locks, 2)
+
+	client.On("Get", anyArgs[:6]...).Return(errors.New("error")).Once()
+	_, err = GetIsiSnapshotLocks(ctx, client, 1)
+	assert.NotNil(t, err)
+}
+
+func TestGetIsiSnapshotLock(t *testing.T) {
+	ctx := context.Background()
+	client := &mocks.Client{}
+
+	client.On("Get", ctx, "platform/1/snapshot/snapshots/1/locks", "2", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	_, err := GetIsiSnapshotLock(ctx, client, 1, 2)
+	assert.Equal(t, errors.New("lock 2 not found on snapshot 1"), err)
+
+	client.On("Get", anyArgs[:6]...).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*IsiSnapshotLocksResp)
+		resp.Locks = []*IsiSnapshotLock{{ID: 2, Comment: "backup"}}
+	}).Once()
+	lock, err := GetIsiSnapshotLock(ctx, client, 1, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, "backup", lock.Comment)
+}
+
+func TestUpdateAndRemoveIsiSnapshotLock(t *testing.T) {
+	ctx := context.Background()
+	client := &mocks.Client{}
+
+	err := UpdateIsiSnapshotLock(ctx, client, 1, 2, nil)
+	assert.Equal(t, errors.New("no snapshot lock update set"), err)
+
+	client.On("Put", ctx, "platform/1/snapshot/snapshots/1/locks", "2", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	err = UpdateIsiSnapshotLock(ctx, client, 1, 2, &IsiSnapshotLockReq{})
+	assert.Nil(t, err)
+
+	client.On("Delete", ctx, "platform/1/snapshot/snapshots/1/locks", "2", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	err = RemoveIsiSnapshotLock(ctx, client, 1, 2)
+	assert.Nil(t, err)
+}
+
+func TestCreateIsiSnapshotAlias(t *testing.T) {
+	ctx := context.Background()
+	client := &mocks.Client{}
+
+	_, err := CreateIsiSnapshotAlias(ctx, client, "", "snap")
+	assert.Equal(t, errors.New("no alias name set"), err)
+	_, err = CreateIsiSnapshotAlias(ctx, client, "alias", "")
+	assert.Equal(t, errors.New("no alias target set"), err)
+
+	client.On("Post", ctx, "platform/1/snapshot/aliases", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(6).(*isiCreateSnapshotObjectResp)
+		resp.ID = 5
+	}).Once()
+	id, err := CreateIsiSnapshotAlias(ctx, client, "alias", "snap")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(5), id)
+}
+
+func TestGetIsiSnapshotAliases(t *testing.T) {
+	ctx := context.Background()
+	client := &mocks.Client{}
+
+	client.On("Get", anyArgs[:6]...).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*IsiSnapshotAliasesResp)
+		resp.Aliases = []*IsiSnapshotAlias{{Name: "alias"}}
+	}).Once()
+	aliases, err := GetIsiSnapshotAliases(ctx, client)
+	assert.Nil(t, err)
+	assert.Len(t, aliases, 1)
+
+	client.On("Get", anyArgs[:6]...).Return(nil).Once()
+	_, err = GetIsiSnapshotAlias(ctx, client, "alias")
+	assert.Equal(t, errors.New("snapshot alias alias not found"), err)
+}
+
+func TestUpdateAndRemoveIsiSnapshotAlias(t *testing.T) {
+	ctx := context.Background()
+	client := &mocks.Client{}
+
+	err := UpdateIsiSnapshotAlias(ctx, client, "alias", nil)
+	assert.Equal(t, errors.New("no snapshot alias update set"), err)
+
+	client.On("Put", ctx, "platform/1/snapshot/aliases", "alias", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	err = UpdateIsiSnapshotAlias(ctx, client, "alias", &IsiSnapshotAliasReq{Target: "snap"})
+	assert.Nil(t, err)
+
+	client.On("Delete", ctx, "platform/1/snapshot/aliases", "alias", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	err = RemoveIsiSnapshotAlias(ctx, client, "alias")
+	assert.Nil(t, err)
+}
+
+func TestGetIsiPendingSnapshots(t *testing.T) {
+	ctx := context.Background()
+	client := &mocks.Client{}
+
+	client.On("Get", ctx, "platform/1/snapshot/pending", "", api.OrderedValues{
+		{[]byte("begin"), []byte("100")},
+		{[]byte("end"), []byte("200")},
+	}, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*IsiPendingSnapshotsResp)
+		resp.Pending = []*IsiPendingSnapshot{{ID: 1, Snapshot: "hourly_1"}}
+		resp.Resume = "next"
+	}).Once()
+	client.On("Get", ctx, "platform/1/snapshot/pending", "", api.OrderedValues{
+		{[]byte("resume"), []byte("next")},
+	}, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*IsiPendingSnapshotsResp)
+		resp.Pending = []*IsiPendingSnapshot{{ID: 2, Snapshot: "hourly_2"}}
+	}).Once()
+	pending, err := GetIsiPendingSnapshots(ctx, client, 100, 200)
+	assert.Nil(t, err)
+	assert.Len(t, pending, 2)
+
+	client.On("Get", anyArgs[:6]...).Return(errors.New("error")).Once()
+	_, err = GetIsiPendingSnapshots(ctx, client, 0, 0)
+	assert.NotNil(t, err)
+}
+
+func TestIsiSnapshotSettings(t *testing.T) {
+	ctx := context.Background()
+	client := &mocks.Client{}
+
+	client.On("Get", ctx, "platform/1/snapshot/settings", "", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*IsiSnapshotSettingsResp)
+		resp.Settings = &IsiSnapshotSettings{Service: true, NfsRootVisible: true}
+	}).Once()
+	settings, err := GetIsiSnapshotSettings(ctx, client)
+	assert.Nil(t, err)
+	assert.True(t, settings.NfsRootVisible)
+
+	client.On("Get", anyArgs[:6]...).Return(nil).Once()
+	_, err = GetIsiSnapshotSettings(ctx, client)
+	assert.Equal(t, errors.New("no snapshot settings returned"), err)
+
+	err = UpdateIsiSnapshotSettings(ctx, client, nil)
+	assert.Equal(t, errors.New("no snapshot settings update set"), err)
+
+	enabled := true
+	client.On("Put", ctx, "platform/1/snapshot/settings", "", mock.Anything, mock.Anything, &IsiSnapshotSettingsReq{NfsRootAccessible: &enabled}, mock.Anything).Return(nil).Once()
+	err = UpdateIsiSnapshotSettings(ctx, client, &IsiSnapshotSettingsReq{NfsRootAccessible: &enabled})
+	assert.Nil(t, err)
+}
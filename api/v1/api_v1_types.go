@@ -24,8 +24,9 @@ const (
 type IsiVolume struct {
 	Name         string `json:"name"`
 	AttributeMap []struct {
-		Name  string      `json:"name"`
-		Value interface{} `json:"value"`
+		Name      string      `json:"name"`
+		Value     interface{} `json:"value"`
+		Namespace string      `json:"namespace,omitempty"`
 	} `json:"attrs"`
 }
 
@@ -66,8 +67,9 @@ type ACLRequest struct {
 // Isi PAPI volume attributes JSON struct
 type GetIsiVolumeAttributesResp struct {
 	AttributeMap []struct {
-		Name  string      `json:"name"`
-		Value interface{} `json:"value"`
+		Name      string      `json:"name"`
+		Value     interface{} `json:"value"`
+		Namespace string      `json:"namespace,omitempty"`
 	} `json:"attrs"`
 }
 
@@ -146,6 +148,127 @@ type GetIsiSnapshotsResp struct {
 	Resume       string         `json:"resume"`
 }
 
+// IsiUpdateSnapshotReq specifies the modifiable properties of a snapshot.
+type IsiUpdateSnapshotReq struct {
+	// Specifies a new name for the snapshot.
+	Name string `json:"name,omitempty"`
+	// Specifies the Unix Epoch time at which the snapshot will expire, 0 clears the expiry.
+	Expires *int64 `json:"expires,omitempty"`
+	// Specifies an alias name to create for the snapshot.
+	Alias string `json:"alias,omitempty"`
+}
+
+// IsiSnapshotLock contains information of a lock held on a snapshot.
+type IsiSnapshotLock struct {
+	// Specifies a user-defined comment describing the lock.
+	Comment string `json:"comment,omitempty"`
+	// Specifies the number of times the lock has been acquired.
+	Count int64 `json:"count,omitempty"`
+	// Specifies the Unix Epoch time at which the lock will expire.
+	Expires int64 `json:"expires,omitempty"`
+	// Specifies the system-assigned ID of the lock.
+	ID int64 `json:"id,omitempty"`
+}
+
+// IsiSnapshotLockReq specifies the properties of a snapshot lock.
+type IsiSnapshotLockReq struct {
+	Comment string `json:"comment,omitempty"`
+	Expires *int64 `json:"expires,omitempty"`
+}
+
+// isiCreateSnapshotObjectResp is returned when a snapshot lock or alias is created
+type isiCreateSnapshotObjectResp struct {
+	ID int64 `json:"id"`
+}
+
+type IsiSnapshotLocksResp struct {
+	Locks  []*IsiSnapshotLock `json:"locks"`
+	Resume string             `json:"resume,omitempty"`
+	Total  int64              `json:"total,omitempty"`
+}
+
+// IsiSnapshotAlias contains information of a snapshot alias.
+type IsiSnapshotAlias struct {
+	// Specifies the Unix Epoch time at which the alias was created.
+	Created int64 `json:"created,omitempty"`
+	// Specifies the system-assigned ID of the alias.
+	ID int64 `json:"id,omitempty"`
+	// Specifies the name of the alias.
+	Name string `json:"name"`
+	// Specifies the ID of the snapshot the alias points to.
+	TargetID int64 `json:"target_id,omitempty"`
+	// Specifies the name of the snapshot the alias points to.
+	TargetName string `json:"target_name,omitempty"`
+}
+
+// IsiSnapshotAliasReq specifies the properties of a snapshot alias.
+type IsiSnapshotAliasReq struct {
+	Name string `json:"name,omitempty"`
+	// Specifies the name or ID of the target snapshot, or "live" to point at the head filesystem.
+	Target string `json:"target,omitempty"`
+}
+
+type IsiSnapshotAliasesResp struct {
+	Aliases []*IsiSnapshotAlias `json:"aliases"`
+	Resume  string              `json:"resume,omitempty"`
+	Total   int64               `json:"total,omitempty"`
+}
+
+// IsiPendingSnapshot is a snapshot a schedule will create in the future
+type IsiPendingSnapshot struct {
+	ID       int64  `json:"id"`
+	Path     string `json:"path"`
+	Schedule string `json:"schedule"`
+	Snapshot string `json:"snapshot"`
+	Time     int64  `json:"time"`
+}
+
+type IsiPendingSnapshotsResp struct {
+	Pending []*IsiPendingSnapshot `json:"pending"`
+	Resume  string                `json:"resume,omitempty"`
+	Total   int64                 `json:"total,omitempty"`
+}
+
+// IsiSnapshotSettings holds the cluster wide snapshot settings
+type IsiSnapshotSettings struct {
+	Autocreate            bool  `json:"autocreate"`
+	Autodelete            bool  `json:"autodelete"`
+	CifsRootAccessible    bool  `json:"cifs_root_accessible"`
+	CifsRootVisible       bool  `json:"cifs_root_visible"`
+	CifsSubdirAccessible  bool  `json:"cifs_subdir_accessible"`
+	GlobalVisibleAccess   bool  `json:"global_visible_accessible"`
+	LocalRootAccessible   bool  `json:"local_root_accessible"`
+	LocalRootVisible      bool  `json:"local_root_visible"`
+	LocalSubdirAccessible bool  `json:"local_subdir_accessible"`
+	NfsRootAccessible     bool  `json:"nfs_root_accessible"`
+	NfsRootVisible        bool  `json:"nfs_root_visible"`
+	NfsSubdirAccessible   bool  `json:"nfs_subdir_accessible"`
+	Reserve               int64 `json:"reserve"`
+	Service               bool  `json:"service"`
+}
+
+type IsiSnapshotSettingsResp struct {
+	Settings *IsiSnapshotSettings `json:"settings"`
+}
+
+// IsiSnapshotSettingsReq modifies the cluster wide snapshot settings, nil fields are left unchanged
+type IsiSnapshotSettingsReq struct {
+	Autocreate            *bool  `json:"autocreate,omitempty"`
+	Autodelete            *bool  `json:"autodelete,omitempty"`
+	CifsRootAccessible    *bool  `json:"cifs_root_accessible,omitempty"`
+	CifsRootVisible       *bool  `json:"cifs_root_visible,omitempty"`
+	CifsSubdirAccessible  *bool  `json:"cifs_subdir_accessible,omitempty"`
+	GlobalVisibleAccess   *bool  `json:"global_visible_accessible,omitempty"`
+	LocalRootAccessible   *bool  `json:"local_root_accessible,omitempty"`
+	LocalRootVisible      *bool  `json:"local_root_visible,omitempty"`
+	LocalSubdirAccessible *bool  `json:"local_subdir_accessible,omitempty"`
+	NfsRootAccessible     *bool  `json:"nfs_root_accessible,omitempty"`
+	NfsRootVisible        *bool  `json:"nfs_root_visible,omitempty"`
+	NfsSubdirAccessible   *bool  `json:"nfs_subdir_accessible,omitempty"`
+	Reserve               *int64 `json:"reserve,omitempty"`
+	Service               *bool  `json:"service,omitempty"`
+}
+
 type isiThresholds struct {
 	Advisory             int64       `json:"advisory"`
 	AdvisoryExceeded     bool        `json:"advisory_exceeded"`
@@ -468,3 +591,205 @@ type IsiGroupListRespResume struct {
 	// Provide this token as the 'resume' query argument to continue listing results.
 	Resume string `json:"resume,omitempty"`
 }
+
+// IsiJobReq specifies the properties of a job engine job to start.
+type IsiJobReq struct {
+	// Specifies the type of the job, e.g. SnapRevert, DomainMark or TreeDelete.
+	Type string `json:"type"`
+	// Whether or not to queue the job if one of the same type is already running or queued.
+	AllowDup bool `json:"allow_dup,omitempty"`
+	// Specifies the paths the job operates on.
+	Paths []string `json:"paths,omitempty"`
+	// Specifies the impact policy name or ID.
+	Policy string `json:"policy,omitempty"`
+	// Specifies the job priority, 1 to 10 where 1 is the highest.
+	Priority int `json:"priority,omitempty"`
+	// Specifies the parameters of a DomainMark job.
+	DomainmarkParams *IsiJobDomainmarkParams `json:"domainmark_params,omitempty"`
+	// Specifies the parameters of a SnapRevert job.
+	SnaprevertParams *IsiJobSnaprevertParams `json:"snaprevert_params,omitempty"`
+	// Specifies the parameters of a ChangelistCreate job.
+	ChangelistcreateParams *IsiJobChangelistcreateParams `json:"changelistcreate_params,omitempty"`
+}
+
+// IsiJobDomainmarkParams specifies the domain a DomainMark job creates or removes.
+type IsiJobDomainmarkParams struct {
+	// Specifies the root path of the domain.
+	Root string `json:"root"`
+	// Specifies the type of the domain, e.g. SnapRevert or SyncIQ.
+	DmType string `json:"dm_type"`
+	// Whether to remove the domain instead of creating it.
+	Delete bool `json:"delete,omitempty"`
+}
+
+// IsiJobSnaprevertParams specifies the snapshot a SnapRevert job reverts to.
+type IsiJobSnaprevertParams struct {
+	Snapid int64 `json:"snapid"`
+}
+
+// IsiJobChangelistcreateParams specifies the snapshots a ChangelistCreate job compares.
+type IsiJobChangelistcreateParams struct {
+	NewerSnapid int64 `json:"newer_snapid"`
+	OlderSnapid int64 `json:"older_snapid"`
+	// Whether to keep the replication state used to compute the changelist.
+	RetainRepstate bool `json:"retain_repstate,omitempty"`
+}
+
+type isiStartJobResp struct {
+	ID int64 `json:"id"`
+}
+
+type isiUpdateJobReq struct {
+	State string `json:"state"`
+}
+
+// IsiJob contains information of a job engine job.
+type IsiJob struct {
+	// Specifies the Unix Epoch time at which the job was created.
+	CreateTime int64 `json:"create_time,omitempty"`
+	// Specifies the current phase of the job, starting at 1.
+	CurrentPhase int `json:"current_phase,omitempty"`
+	// Specifies the Unix Epoch time at which the job ended.
+	EndTime int64 `json:"end_time,omitempty"`
+	// Specifies the ID of the job.
+	ID int64 `json:"id"`
+	// Specifies the impact policy of the job.
+	Impact string `json:"impact,omitempty"`
+	// Specifies the paths the job operates on.
+	Paths []string `json:"paths,omitempty"`
+	// Specifies the job priority.
+	Priority int `json:"priority,omitempty"`
+	// Specifies a human readable description of the job progress.
+	Progress string `json:"progress,omitempty"`
+	// Specifies the Unix Epoch time at which the job started.
+	StartTime int64 `json:"start_time,omitempty"`
+	// Specifies the state of the job.
+	State string `json:"state"`
+	// Specifies the total number of phases of the job.
+	TotalPhases int `json:"total_phases,omitempty"`
+	// Specifies the type of the job.
+	Type string `json:"type"`
+}
+
+type IsiJobsResp struct {
+	Jobs   []*IsiJob `json:"jobs"`
+	Resume string    `json:"resume,omitempty"`
+	Total  int64     `json:"total,omitempty"`
+}
+
+// IsiJobEvent contains information of an event recorded for a job engine job.
+type IsiJobEvent struct {
+	// Specifies the ID of the event.
+	ID int64 `json:"id"`
+	// Specifies the ID of the job the event belongs to.
+	JobID int64 `json:"job_id"`
+	// Specifies the type of the job the event belongs to.
+	JobType string `json:"job_type,omitempty"`
+	// Specifies the phase of the job the event was recorded in.
+	Phase int `json:"phase,omitempty"`
+	// Specifies the name of the event, e.g. a job state change.
+	Key string `json:"key,omitempty"`
+	// Specifies the Unix Epoch time at which the event was recorded.
+	Time int64 `json:"time,omitempty"`
+	// Specifies additional information recorded with the event.
+	Value interface{} `json:"value,omitempty"`
+}
+
+type IsiJobEventsResp struct {
+	Events []*IsiJobEvent `json:"events"`
+	Resume string         `json:"resume,omitempty"`
+	Total  int64          `json:"total,omitempty"`
+}
+
+// IsiChangelist contains information of a changelist between two snapshots.
+type IsiChangelist struct {
+	// Specifies the ID of the changelist, "<older snapshot ID>_<newer snapshot ID>".
+	ID string `json:"id"`
+	// Specifies the ID of the job that created the changelist.
+	JobID int64 `json:"job_id,omitempty"`
+	// Specifies the number of entries in the changelist.
+	NumEntries int64 `json:"num_entries,omitempty"`
+	// Specifies the root path of the snapshots.
+	Root string `json:"root,omitempty"`
+	// Specifies the ID of the older snapshot.
+	Snap1 int64 `json:"snap1"`
+	// Specifies the ID of the newer snapshot.
+	Snap2 int64 `json:"snap2"`
+	// Specifies the state of the changelist, e.g. in_progress or completed.
+	Status string `json:"status,omitempty"`
+}
+
+type IsiChangelistsResp struct {
+	Changelists []*IsiChangelist `json:"changelists"`
+	Resume      string           `json:"resume,omitempty"`
+	Total       int64            `json:"total,omitempty"`
+}
+
+// IsiChangelistEntry contains information of a file or directory that changed between two snapshots.
+type IsiChangelistEntry struct {
+	// Specifies the LIN of the entry.
+	ID int64 `json:"id"`
+	// Specifies a bit mask of the changes, see the ChangelistEntry* constants.
+	ChangeTypes int `json:"change_types"`
+	// Specifies the path of the entry in the newer snapshot.
+	Path string `json:"path"`
+	// Specifies the type of the entry, e.g. regular or directory.
+	Type string `json:"type,omitempty"`
+	// Specifies the logical size of the entry in bytes.
+	Size int64 `json:"size"`
+	// Specifies the physical size of the entry in bytes.
+	PhysicalSize int64 `json:"physical_size,omitempty"`
+	// Specifies the LIN of the parent directory.
+	ParentLin int64 `json:"parent_lin,omitempty"`
+	// Specifies the Unix Epoch time of the last access.
+	Atime int64 `json:"atime,omitempty"`
+	// Specifies the Unix Epoch time of the last metadata change.
+	Ctime int64 `json:"ctime,omitempty"`
+	// Specifies the Unix Epoch time of the last data modification.
+	Mtime int64 `json:"mtime,omitempty"`
+}
+
+type IsiChangelistEntriesResp struct {
+	Entries []*IsiChangelistEntry `json:"lins"`
+	Resume  string                `json:"resume,omitempty"`
+	Total   int64                 `json:"total,omitempty"`
+}
+
+// IsiAccessACE is an access control entry relevant to the access of a user to a file.
+type IsiAccessACE struct {
+	// Specifies the rights granted or denied, e.g. file_read or dir_gen_write.
+	AccessRights []string `json:"accessrights,omitempty"`
+	// Specifies whether the rights are allowed or denied.
+	AccessType string `json:"accesstype,omitempty"`
+	// Specifies how the entry is inherited.
+	InheritFlags []string               `json:"inherit_flags,omitempty"`
+	Trustee      IsiAccessItemFileGroup `json:"trustee"`
+}
+
+// IsiAccessFile Specifies the owner, group and mode of the checked file.
+type IsiAccessFile struct {
+	FileGroup IsiAccessItemFileGroup `json:"file_group"`
+	FileOwner IsiAccessItemFileGroup `json:"file_owner"`
+	// Specifies the mode bits of the file.
+	Mode string `json:"mode,omitempty"`
+}
+
+// IsiAccessPermissions Specifies the effective permissions of the user on the checked file.
+type IsiAccessPermissions struct {
+	// Specifies the effective rights of the user, separated by commas or spaces.
+	Expected string `json:"expected,omitempty"`
+	// Specifies the access control entries which apply to the user.
+	RelevantACE []IsiAccessACE `json:"relevant_ace,omitempty"`
+}
+
+// IsiUserAccess is the effective access of a user to a file or directory.
+type IsiUserAccess struct {
+	File IsiAccessFile `json:"file"`
+	// Specifies the persona of the checked user.
+	ID          string               `json:"id"`
+	Permissions IsiAccessPermissions `json:"permissions"`
+}
+
+type IsiUserAccessResp struct {
+	Access []*IsiUserAccess `json:"access"`
+}
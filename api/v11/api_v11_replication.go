@@ -17,9 +17,12 @@ package v11
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/dell/goisilon/api"
 )
@@ -29,6 +32,8 @@ const (
 	targetPoliciesPath = "/platform/11/sync/target/policies/"
 	jobsPath           = "/platform/11/sync/jobs/"
 	reportsPath        = "/platform/11/sync/reports"
+	subreportsPath     = "/platform/11/sync/reports/%s/subreports"
+	targetReportsPath  = "/platform/11/sync/target/reports"
 )
 
 type JobAction string
@@ -94,6 +99,131 @@ type Policy struct {
 	JobDelay     int      `json:"job_delay,omitempty"`
 	Schedule     string   `json:"schedule"`
 	LastJobState JobState `json:"last_job_state,omitempty"`
+
+	Description               string               `json:"description,omitempty"`
+	SourceIncludeDirectories  []string             `json:"source_include_directories,omitempty"`
+	SourceExcludeDirectories  []string             `json:"source_exclude_directories,omitempty"`
+	FileMatchingPattern       *FileMatchingPattern `json:"file_matching_pattern,omitempty"`
+	RPOAlert                  *int                 `json:"rpo_alert,omitempty"`
+	AcceleratedFailback       *bool                `json:"accelerated_failback,omitempty"`
+	TargetSnapshotArchive     *bool                `json:"target_snapshot_archive,omitempty"`
+	TargetSnapshotPattern     string               `json:"target_snapshot_pattern,omitempty"`
+	TargetSnapshotAlias       string               `json:"target_snapshot_alias,omitempty"`
+	TargetSnapshotExpiration  *int                 `json:"target_snapshot_expiration,omitempty"`
+	SourceSnapshotArchive     *bool                `json:"source_snapshot_archive,omitempty"`
+	SourceSnapshotPattern     string               `json:"source_snapshot_pattern,omitempty"`
+	SourceSnapshotExpiration  *int                 `json:"source_snapshot_expiration,omitempty"`
+	WorkersPerNode            *int                 `json:"workers_per_node,omitempty"`
+	BandwidthReservation      *int                 `json:"bandwidth_reservation,omitempty"`
+	Priority                  *int                 `json:"priority,omitempty"`
+	EncryptionCipherList      string               `json:"encryption_cipher_list,omitempty"`
+	LogLevel                  PolicyLogLevel       `json:"log_level,omitempty"`
+	TargetCompareInitialSync  *bool                `json:"target_compare_initial_sync,omitempty"`
+	SkipWhenSourceUnmodified  *bool                `json:"skip_when_source_unmodified,omitempty"`
+	ReportMaxAge              *int                 `json:"report_max_age,omitempty"`
+	ReportMaxCount            *int                 `json:"report_max_count,omitempty"`
+	Conflicted                bool                 `json:"conflicted,omitempty"`
+	LastStarted               int64                `json:"last_started,omitempty"`
+	LastSuccess               int64                `json:"last_success,omitempty"`
+	NextJob                   int64                `json:"next_job,omitempty"`
+	ExpectedDataloss          bool                 `json:"expected_dataloss,omitempty"`
+	TargetDetectModifications *bool                `json:"target_detect_modifications,omitempty"`
+	SnapshotSyncExisting      *bool                `json:"snapshot_sync_existing,omitempty"`
+	SnapshotSyncPattern       string               `json:"snapshot_sync_pattern,omitempty"`
+	DisableStf                *bool                `json:"disable_stf,omitempty"`
+	DisableFileSplit          *bool                `json:"disable_file_split,omitempty"`
+	CheckIntegrity            *bool                `json:"check_integrity,omitempty"`
+	LogRemovedFiles           *bool                `json:"log_removed_files,omitempty"`
+	IgnoreRecursiveQuota      *bool                `json:"ignore_recursive_quota,omitempty"`
+	RestrictTargetNetwork     *bool                `json:"restrict_target_network,omitempty"`
+	ForceInterface            *bool                `json:"force_interface,omitempty"`
+	SourceNetwork             *PolicySourceNetwork `json:"source_network,omitempty"`
+}
+
+// PolicyUpdate modifies a SyncIQ policy, nil fields are left unchanged.
+type PolicyUpdate struct {
+	Action                    *string              `json:"action,omitempty"`
+	Name                      *string              `json:"name,omitempty"`
+	Description               *string              `json:"description,omitempty"`
+	Enabled                   *bool                `json:"enabled,omitempty"`
+	SourcePath                *string              `json:"source_root_path,omitempty"`
+	TargetPath                *string              `json:"target_path,omitempty"`
+	TargetHost                *string              `json:"target_host,omitempty"`
+	TargetCert                *string              `json:"target_certificate_id,omitempty"`
+	JobDelay                  *int                 `json:"job_delay,omitempty"`
+	Schedule                  *string              `json:"schedule,omitempty"`
+	SourceIncludeDirectories  *[]string            `json:"source_include_directories,omitempty"`
+	SourceExcludeDirectories  *[]string            `json:"source_exclude_directories,omitempty"`
+	FileMatchingPattern       *FileMatchingPattern `json:"file_matching_pattern,omitempty"`
+	RPOAlert                  *int                 `json:"rpo_alert,omitempty"`
+	AcceleratedFailback       *bool                `json:"accelerated_failback,omitempty"`
+	TargetSnapshotArchive     *bool                `json:"target_snapshot_archive,omitempty"`
+	TargetSnapshotPattern     *string              `json:"target_snapshot_pattern,omitempty"`
+	TargetSnapshotAlias       *string              `json:"target_snapshot_alias,omitempty"`
+	TargetSnapshotExpiration  *int                 `json:"target_snapshot_expiration,omitempty"`
+	SourceSnapshotArchive     *bool                `json:"source_snapshot_archive,omitempty"`
+	SourceSnapshotPattern     *string              `json:"source_snapshot_pattern,omitempty"`
+	SourceSnapshotExpiration  *int                 `json:"source_snapshot_expiration,omitempty"`
+	WorkersPerNode            *int                 `json:"workers_per_node,omitempty"`
+	BandwidthReservation      *int                 `json:"bandwidth_reservation,omitempty"`
+	Priority                  *int                 `json:"priority,omitempty"`
+	EncryptionCipherList      *string              `json:"encryption_cipher_list,omitempty"`
+	LogLevel                  *PolicyLogLevel      `json:"log_level,omitempty"`
+	TargetCompareInitialSync  *bool                `json:"target_compare_initial_sync,omitempty"`
+	SkipWhenSourceUnmodified  *bool                `json:"skip_when_source_unmodified,omitempty"`
+	ReportMaxAge              *int                 `json:"report_max_age,omitempty"`
+	ReportMaxCount            *int                 `json:"report_max_count,omitempty"`
+	SnapshotSyncExisting      *bool                `json:"snapshot_sync_existing,omitempty"`
+	SnapshotSyncPattern       *string              `json:"snapshot_sync_pattern,omitempty"`
+	CheckIntegrity            *bool                `json:"check_integrity,omitempty"`
+	LogRemovedFiles           *bool                `json:"log_removed_files,omitempty"`
+	RestrictTargetNetwork     *bool                `json:"restrict_target_network,omitempty"`
+	TargetDetectModifications *bool                `json:"target_detect_modifications,omitempty"`
+	DisableStf                *bool                `json:"disable_stf,omitempty"`
+	DisableFileSplit          *bool                `json:"disable_file_split,omitempty"`
+	IgnoreRecursiveQuota      *bool                `json:"ignore_recursive_quota,omitempty"`
+	ForceInterface            *bool                `json:"force_interface,omitempty"`
+	SourceNetwork             *PolicySourceNetwork `json:"source_network,omitempty"`
+}
+
+type PolicyLogLevel string
+
+const (
+	LogLevelFatal  PolicyLogLevel = "fatal"
+	LogLevelError  PolicyLogLevel = "error"
+	LogLevelNotice PolicyLogLevel = "notice"
+	LogLevelInfo   PolicyLogLevel = "info"
+	LogLevelCopy   PolicyLogLevel = "copy"
+	LogLevelDebug  PolicyLogLevel = "debug"
+	LogLevelTrace  PolicyLogLevel = "trace"
+)
+
+// FileMatchingPattern selects the files a policy replicates. A file matches
+// when every criterion of any one of the OrCriteria matches.
+type FileMatchingPattern struct {
+	OrCriteria []FileMatchingAndCriteria `json:"or_criteria"`
+}
+
+type FileMatchingAndCriteria struct {
+	AndCriteria []FileMatchingCriterion `json:"and_criteria"`
+}
+
+// FileMatchingCriterion compares a file attribute such as name, path, size,
+// accessed_time or birth_time with a value, for example {Type: "name", Operator: "==", Value: "*.log"}.
+type FileMatchingCriterion struct {
+	Type            string `json:"type"`
+	Operator        string `json:"operator,omitempty"`
+	Value           string `json:"value,omitempty"`
+	Field           string `json:"field,omitempty"`
+	AttributeExists *bool  `json:"attribute_exists,omitempty"`
+	CaseSensitive   *bool  `json:"case_sensitive,omitempty"`
+	WholeWord       *bool  `json:"whole_word,omitempty"`
+}
+
+// PolicySourceNetwork restricts replication to the nodes of a subnet and pool.
+type PolicySourceNetwork struct {
+	Interface string `json:"interface,omitempty"`
+	Subnet    string `json:"subnet,omitempty"`
 }
 
 type ResolvePolicyReq struct {
@@ -106,10 +236,14 @@ type ResolvePolicyReq struct {
 
 type Policies struct {
 	Policy []Policy `json:"policies,omitempty"`
+	Resume string   `json:"resume,omitempty"`
+	Total  int64    `json:"total,omitempty"`
 }
 
 type Reports struct {
 	Reports []Report `json:"reports,omitempty"`
+	Resume  string   `json:"resume,omitempty"`
+	Total   int64    `json:"total,omitempty"`
 }
 
 type TargetPolicy struct {
@@ -138,6 +272,68 @@ type JobRequest struct {
 type Job struct {
 	Action RunningJobAction `json:"policy_action,omitempty"`
 	ID     string           `json:"id,omitempty"` // ID or Name of policy
+
+	JobID   int64       `json:"job_id,omitempty"`
+	State   JobState    `json:"state,omitempty"`
+	EndTime int64       `json:"end_time,omitempty"`
+	Retry   int64       `json:"retry,omitempty"`
+	Workers []JobWorker `json:"workers,omitempty"`
+	Errors  []string    `json:"errors,omitempty"`
+	Error   string      `json:"error,omitempty"`
+	JobStatistics
+}
+
+// JobStatistics holds the progress of a SyncIQ job, shared by running jobs and their reports
+type JobStatistics struct {
+	PolicyID   string `json:"policy_id,omitempty"`
+	PolicyName string `json:"policy_name,omitempty"`
+	SyncType   string `json:"sync_type,omitempty"`
+	StartTime  int64  `json:"start_time,omitempty"`
+	Duration   int64  `json:"duration,omitempty"` // seconds
+
+	TotalFiles        int64 `json:"total_files,omitempty"`
+	FilesTransferred  int64 `json:"files_transferred,omitempty"`
+	FilesNew          int64 `json:"files_new,omitempty"`
+	FilesChanged      int64 `json:"files_changed,omitempty"`
+	FilesSkipped      int64 `json:"up_to_date_files_skipped,omitempty"`
+	FilesUnlinked     int64 `json:"files_unlinked,omitempty"`
+	TotalDataBytes    int64 `json:"total_data_bytes,omitempty"`
+	BytesTransferred  int64 `json:"bytes_transferred,omitempty"`
+	FileDataBytes     int64 `json:"file_data_bytes,omitempty"`
+	TotalNetworkBytes int64 `json:"total_network_bytes,omitempty"`
+	TotalChunks       int64 `json:"total_chunks,omitempty"`
+	SucceededChunks   int64 `json:"succeeded_chunks,omitempty"`
+	FailedChunks      int64 `json:"failed_chunks,omitempty"`
+	RunningChunks     int64 `json:"running_chunks,omitempty"`
+
+	TotalPhases int64      `json:"total_phases,omitempty"`
+	Phases      []JobPhase `json:"phases,omitempty"`
+	Warnings    []string   `json:"warnings,omitempty"`
+}
+
+// JobPhase is a phase of a SyncIQ job, such as STF_PHASE_IDMAP_SEND
+type JobPhase struct {
+	Phase      string                 `json:"phase"`
+	StartTime  int64                  `json:"start_time,omitempty"`
+	EndTime    int64                  `json:"end_time,omitempty"`
+	Statistics map[string]interface{} `json:"statistics,omitempty"`
+}
+
+// JobWorker is a worker process replicating part of a SyncIQ job
+type JobWorker struct {
+	WorkerID     int64  `json:"worker_id"`
+	Lnn          int64  `json:"lnn,omitempty"`
+	ProcessID    int64  `json:"process_id,omitempty"`
+	Connected    bool   `json:"connected"`
+	SourceHost   string `json:"source_host,omitempty"`
+	TargetHost   string `json:"target_host,omitempty"`
+	LinHashRange string `json:"lin_hash_range,omitempty"`
+	LastSplit    int64  `json:"last_split,omitempty"`
+	LastWork     int64  `json:"last_work,omitempty"`
+}
+
+type jobStateReq struct {
+	State JobState `json:"state"`
 }
 
 type Jobs struct {
@@ -151,6 +347,84 @@ type Report struct {
 	State   JobState `json:"state,omitempty"`
 	EndTime int64    `json:"end_time"`
 	Errors  []string `json:"errors"`
+	JobStatistics
+	SubreportsCount int64 `json:"num_subreports,omitempty"`
+}
+
+type Subreports struct {
+	Subreports []Report `json:"subreports,omitempty"`
+	Resume     string   `json:"resume,omitempty"`
+	Total      int64    `json:"total,omitempty"`
+}
+
+// ReportFilter selects the reports returned by ListReports and ListTargetReports.
+// Zero fields do not filter.
+type ReportFilter struct {
+	PolicyName string
+	State      JobState
+	// NewerThan only returns reports of jobs started within the given number of days
+	NewerThan int
+	// ReportsPerPolicy only returns the given number of most recent reports of each policy
+	ReportsPerPolicy int
+	// Limit is the page size
+	Limit int
+	// Sort is the report field to sort by, such as end_time, Dir is ASC or DESC
+	Sort string
+	Dir  string
+	// StartedAfter and StartedBefore are not supported by the cluster, they
+	// are applied to each page by the client. StartedAfter also sets
+	// NewerThan if it is not set so that the cluster returns fewer reports.
+	StartedAfter  time.Time
+	StartedBefore time.Time
+}
+
+func (f *ReportFilter) values(now time.Time) api.OrderedValues {
+	var params api.OrderedValues
+	if f == nil {
+		return params
+	}
+	add := func(key, value string) {
+		params = append(params, [][]byte{[]byte(key), []byte(value)})
+	}
+	if f.PolicyName != "" {
+		add(string(policyNameArg), f.PolicyName)
+	}
+	if f.State != "" {
+		add("state", string(f.State))
+	}
+	newerThan := f.NewerThan
+	if newerThan == 0 && !f.StartedAfter.IsZero() {
+		newerThan = int(math.Ceil(now.Sub(f.StartedAfter).Hours() / 24))
+	}
+	if newerThan > 0 {
+		add("newer_than", strconv.Itoa(newerThan))
+	}
+	if f.ReportsPerPolicy > 0 {
+		add(string(reportsPerPolicyArg), strconv.Itoa(f.ReportsPerPolicy))
+	}
+	if f.Limit > 0 {
+		add("limit", strconv.Itoa(f.Limit))
+	}
+	if f.Sort != "" {
+		add(string(sortArg), f.Sort)
+	}
+	if f.Dir != "" {
+		add("dir", f.Dir)
+	}
+	return params
+}
+
+func (f *ReportFilter) matches(r Report) bool {
+	if f == nil {
+		return true
+	}
+	if !f.StartedAfter.IsZero() && r.StartTime < f.StartedAfter.Unix() {
+		return false
+	}
+	if !f.StartedBefore.IsZero() && r.StartTime >= f.StartedBefore.Unix() {
+		return false
+	}
+	return true
 }
 
 // GetPolicyByName returns policy by name
@@ -191,6 +465,59 @@ func CreatePolicy(ctx context.Context, client api.Client, name string, sourcePat
 	return client.Post(ctx, policiesPath, "", nil, nil, body, &policyResp)
 }
 
+// GetPolicies returns all SyncIQ policies
+func GetPolicies(ctx context.Context, client api.Client) ([]Policy, error) {
+	var policies []Policy
+	var params api.OrderedValues
+	for {
+		p := &Policies{}
+		err := client.Get(ctx, policiesPath, "", params, nil, &p)
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, p.Policy...)
+		if p.Resume == "" {
+			break
+		}
+		params = api.OrderedValues{
+			{[]byte("resume"), []byte(p.Resume)},
+		}
+	}
+	return policies, nil
+}
+
+// CreatePolicyWithParams creates a SyncIQ policy from the full policy model and returns its ID
+func CreatePolicyWithParams(ctx context.Context, client api.Client, policy *Policy) (string, error) {
+	if policy == nil {
+		return "", errors.New("no policy set")
+	}
+	body := *policy
+	// drop the read only fields so that a fetched policy can be used as a template
+	body.ID = ""
+	body.LastJobState = ""
+	body.Conflicted = false
+	body.LastStarted = 0
+	body.LastSuccess = 0
+	body.NextJob = 0
+	body.ExpectedDataloss = false
+	if body.Action == "" {
+		body.Action = string(SYNC)
+	}
+	var policyResp Policy
+	if err := client.Post(ctx, policiesPath, "", nil, nil, &body, &policyResp); err != nil {
+		return "", err
+	}
+	return policyResp.ID, nil
+}
+
+// UpdatePolicyWithParams modifies the fields of a SyncIQ policy that are set in update
+func UpdatePolicyWithParams(ctx context.Context, client api.Client, id string, update *PolicyUpdate) error {
+	if update == nil {
+		return errors.New("no policy update set")
+	}
+	return client.Put(ctx, policiesPath, id, nil, nil, update, nil)
+}
+
 func DeletePolicy(ctx context.Context, client api.Client, name string) error {
 	resp := ""
 	return client.Delete(ctx, policiesPath, name, nil, nil, &resp)
@@ -229,6 +556,30 @@ func StartSyncIQJob(ctx context.Context, client api.Client, job *JobRequest) (*J
 	return &jobResp, client.Post(ctx, jobsPath, "", nil, nil, job, &jobResp)
 }
 
+// GetSyncIQJob returns the running job of a policy, including its progress
+func GetSyncIQJob(ctx context.Context, client api.Client, id string) (*Job, error) {
+	j := &Jobs{}
+	err := client.Get(ctx, jobsPath, id, nil, nil, &j)
+	if err != nil {
+		return nil, err
+	}
+	if len(j.Job) == 0 {
+		return nil, fmt.Errorf("successful code returned, but job %s not found", id)
+	}
+	return &j.Job[0], nil
+}
+
+// UpdateSyncIQJobState pauses, resumes or cancels the running job of a policy
+// by setting its state to PAUSED, RUNNING or CANCELED
+func UpdateSyncIQJobState(ctx context.Context, client api.Client, id string, state JobState) error {
+	switch state {
+	case PAUSED, RUNNING, CANCELED:
+	default:
+		return fmt.Errorf("cannot set job %s to state %s", id, state)
+	}
+	return client.Put(ctx, jobsPath, id, nil, nil, &jobStateReq{State: state}, nil)
+}
+
 func GetReport(ctx context.Context, client api.Client, reportName string) (*Report, error) {
 	r := &Reports{}
 	err := client.Get(ctx, reportsPath, reportName, nil, nil, &r)
@@ -261,6 +612,56 @@ func GetReportsByPolicyName(ctx context.Context, client api.Client, policyName s
 	return r, nil
 }
 
+// ListReports returns one page of the reports matching filter, an empty resume token returns the first page
+func ListReports(ctx context.Context, client api.Client, filter *ReportFilter, resume string) (*Reports, error) {
+	return listReports(ctx, client, reportsPath, filter, resume)
+}
+
+// ListTargetReports returns one page of the reports of jobs replicating to this cluster
+// matching filter, an empty resume token returns the first page
+func ListTargetReports(ctx context.Context, client api.Client, filter *ReportFilter, resume string) (*Reports, error) {
+	return listReports(ctx, client, targetReportsPath, filter, resume)
+}
+
+func listReports(ctx context.Context, client api.Client, path string, filter *ReportFilter, resume string) (*Reports, error) {
+	params := filter.values(time.Now())
+	if resume != "" {
+		// the resume token carries the original query
+		params = api.OrderedValues{
+			{[]byte("resume"), []byte(resume)},
+		}
+	}
+	r := &Reports{}
+	err := client.Get(ctx, path, "", params, nil, &r)
+	if err != nil {
+		return nil, err
+	}
+	reports := r.Reports[:0]
+	for _, report := range r.Reports {
+		if filter.matches(report) {
+			reports = append(reports, report)
+		}
+	}
+	r.Reports = reports
+	return r, nil
+}
+
+// ListSubreports returns one page of the sub-reports of a report, an empty resume token returns the first page
+func ListSubreports(ctx context.Context, client api.Client, reportID string, resume string) (*Subreports, error) {
+	var params api.OrderedValues
+	if resume != "" {
+		params = api.OrderedValues{
+			{[]byte("resume"), []byte(resume)},
+		}
+	}
+	r := &Subreports{}
+	err := client.Get(ctx, fmt.Sprintf(subreportsPath, reportID), "", params, nil, &r)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
 func GetJobsByPolicyName(ctx context.Context, client api.Client, policyName string) ([]Job, error) {
 	j := &Jobs{}
 	err := client.Get(ctx, jobsPath, policyName, nil, nil, &j)
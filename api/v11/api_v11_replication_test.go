@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/dell/goisilon/api"
 	"github.com/dell/goisilon/mocks"
@@ -206,3 +207,178 @@ func TestStartSyncIQJob(t *testing.T) {
 	_, err := StartSyncIQJob(ctx, client, &jobRequest)
 	assert.Equal(t, nil, err)
 }
+
+func TestGetPolicies(t *testing.T) {
+	ctx := context.Background()
+	client := &mocks.Client{}
+
+	client.On("Get", ctx, policiesPath, "", api.OrderedValues(nil), mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(**Policies)
+		*resp = &Policies{Policy: []Policy{{Name: "p1"}}, Resume: "next"}
+	}).Once()
+	client.On("Get", ctx, policiesPath, "", api.OrderedValues{{[]byte("resume"), []byte("next")}}, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(**Policies)
+		*resp = &Policies{Policy: []Policy{{Name: "p2"}}}
+	}).Once()
+	policies, err := GetPolicies(ctx, client)
+	assert.NoError(t, err)
+	assert.Equal(t, []Policy{{Name: "p1"}, {Name: "p2"}}, policies)
+
+	client.On("Get", anyArgs[:6]...).Return(errors.New("error")).Once()
+	_, err = GetPolicies(ctx, client)
+	assert.Error(t, err)
+}
+
+func TestCreatePolicyWithParams(t *testing.T) {
+	ctx := context.Background()
+	client := &mocks.Client{}
+
+	_, err := CreatePolicyWithParams(ctx, client, nil)
+	assert.Equal(t, errors.New("no policy set"), err)
+
+	workers := 4
+	policy := &Policy{
+		ID:                       "old-id",
+		Name:                     "policy",
+		SourcePath:               "/ifs/data",
+		TargetPath:               "/ifs/data",
+		TargetHost:               "10.0.0.1",
+		SourceExcludeDirectories: []string{"/ifs/data/tmp"},
+		WorkersPerNode:           &workers,
+		LastJobState:             FINISHED,
+		FileMatchingPattern: &FileMatchingPattern{OrCriteria: []FileMatchingAndCriteria{{
+			AndCriteria: []FileMatchingCriterion{{Type: "name", Operator: "!=", Value: "*.tmp"}},
+		}}},
+	}
+	client.On("Post", ctx, policiesPath, "", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		body := args.Get(5).(*Policy)
+		assert.Equal(t, "", body.ID)
+		assert.Equal(t, JobState(""), body.LastJobState)
+		assert.Equal(t, "sync", body.Action)
+		assert.Equal(t, &workers, body.WorkersPerNode)
+		resp := args.Get(6).(*Policy)
+		resp.ID = "new-id"
+	}).Once()
+	id, err := CreatePolicyWithParams(ctx, client, policy)
+	assert.NoError(t, err)
+	assert.Equal(t, "new-id", id)
+	assert.Equal(t, "old-id", policy.ID)
+}
+
+func TestUpdatePolicyWithParams(t *testing.T) {
+	ctx := context.Background()
+	client := &mocks.Client{}
+
+	err := UpdatePolicyWithParams(ctx, client, "policy", nil)
+	assert.Equal(t, errors.New("no policy update set"), err)
+
+	level := LogLevelNotice
+	update := &PolicyUpdate{LogLevel: &level}
+	client.On("Put", ctx, policiesPath, "policy", mock.Anything, mock.Anything, update, mock.Anything).Return(nil).Once()
+	err = UpdatePolicyWithParams(ctx, client, "policy", update)
+	assert.NoError(t, err)
+}
+
+func TestGetSyncIQJob(t *testing.T) {
+	ctx := context.Background()
+	client := &mocks.Client{}
+
+	client.On("Get", ctx, jobsPath, "policy", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(**Jobs)
+		*resp = &Jobs{Job: []Job{{ID: "policy", State: RUNNING, JobStatistics: JobStatistics{BytesTransferred: 1024, Phases: []JobPhase{{Phase: "STF_PHASE_DATA"}}}}}}
+	}).Once()
+	job, err := GetSyncIQJob(ctx, client, "policy")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1024), job.BytesTransferred)
+	assert.Equal(t, "STF_PHASE_DATA", job.Phases[0].Phase)
+
+	client.On("Get", anyArgs[:6]...).Return(nil).Once()
+	_, err = GetSyncIQJob(ctx, client, "policy")
+	assert.Equal(t, errors.New("successful code returned, but job policy not found"), err)
+
+	client.On("Get", anyArgs[:6]...).Return(errors.New("error")).Once()
+	_, err = GetSyncIQJob(ctx, client, "policy")
+	assert.Error(t, err)
+}
+
+func TestUpdateSyncIQJobState(t *testing.T) {
+	ctx := context.Background()
+	client := &mocks.Client{}
+
+	for _, state := range []JobState{PAUSED, RUNNING, CANCELED} {
+		client.On("Put", ctx, jobsPath, "policy", mock.Anything, mock.Anything, &jobStateReq{State: state}, mock.Anything).Return(nil).Once()
+		assert.NoError(t, UpdateSyncIQJobState(ctx, client, "policy", state))
+	}
+
+	err := UpdateSyncIQJobState(ctx, client, "policy", FINISHED)
+	assert.Equal(t, errors.New("cannot set job policy to state finished"), err)
+}
+
+func TestReportFilterValues(t *testing.T) {
+	now := time.Unix(10*24*3600, 0)
+	filter := &ReportFilter{
+		PolicyName:       "policy",
+		State:            FINISHED,
+		ReportsPerPolicy: 5,
+		Limit:            100,
+		Sort:             "end_time",
+		Dir:              "DESC",
+		StartedAfter:     now.Add(-36 * time.Hour),
+	}
+	assert.Equal(t, api.OrderedValues{
+		{[]byte("policy_name"), []byte("policy")},
+		{[]byte("state"), []byte("finished")},
+		{[]byte("newer_than"), []byte("2")},
+		{[]byte("reports_per_policy"), []byte("5")},
+		{[]byte("limit"), []byte("100")},
+		{[]byte("sort"), []byte("end_time")},
+		{[]byte("dir"), []byte("DESC")},
+	}, filter.values(now))
+
+	var nilFilter *ReportFilter
+	assert.Nil(t, nilFilter.values(now))
+	assert.True(t, nilFilter.matches(Report{}))
+}
+
+func TestListReports(t *testing.T) {
+	ctx := context.Background()
+	client := &mocks.Client{}
+	filter := &ReportFilter{PolicyName: "policy", StartedBefore: time.Unix(200, 0)}
+
+	client.On("Get", ctx, reportsPath, "", api.OrderedValues{{[]byte("policy_name"), []byte("policy")}}, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(**Reports)
+		*resp = &Reports{
+			Reports: []Report{
+				{ID: "1", JobStatistics: JobStatistics{StartTime: 100}},
+				{ID: "2", JobStatistics: JobStatistics{StartTime: 300}},
+			},
+			Resume: "next",
+		}
+	}).Once()
+	reports, err := ListReports(ctx, client, filter, "")
+	assert.NoError(t, err)
+	assert.Len(t, reports.Reports, 1)
+	assert.Equal(t, "1", reports.Reports[0].ID)
+	assert.Equal(t, "next", reports.Resume)
+
+	client.On("Get", ctx, targetReportsPath, "", api.OrderedValues{{[]byte("resume"), []byte("next")}}, mock.Anything, mock.Anything).Return(errors.New("error")).Once()
+	_, err = ListTargetReports(ctx, client, filter, "next")
+	assert.Error(t, err)
+}
+
+func TestListSubreports(t *testing.T) {
+	ctx := context.Background()
+	client := &mocks.Client{}
+
+	client.On("Get", ctx, "/platform/11/sync/reports/1-policy/subreports", "", api.OrderedValues(nil), mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(**Subreports)
+		*resp = &Subreports{Subreports: []Report{{ID: "1"}}}
+	}).Once()
+	subreports, err := ListSubreports(ctx, client, "1-policy", "")
+	assert.NoError(t, err)
+	assert.Len(t, subreports.Subreports, 1)
+
+	client.On("Get", anyArgs[:6]...).Return(errors.New("error")).Once()
+	_, err = ListSubreports(ctx, client, "1-policy", "next")
+	assert.Error(t, err)
+}
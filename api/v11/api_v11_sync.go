@@ -0,0 +1,261 @@
+/*
+Copyright (c) 2025 Dell Inc, or its subsidiaries.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v11
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dell/goisilon/api"
+)
+
+const (
+	syncRulesPath    = "/platform/11/sync/rules/"
+	syncSettingsPath = "/platform/11/sync/settings"
+)
+
+type SyncRuleType string
+
+const (
+	// BandwidthRule limits the network bandwidth used by SyncIQ, in kbps
+	BandwidthRule SyncRuleType = "bandwidth"
+	// FileCountRule limits the number of files SyncIQ sends, per second
+	FileCountRule SyncRuleType = "file_count"
+	// CPURule limits the CPU used by SyncIQ workers, in percent
+	CPURule SyncRuleType = "cpu"
+	// WorkerRule limits the number of SyncIQ workers, in percent of the maximum
+	WorkerRule SyncRuleType = "worker"
+)
+
+type SyncService string
+
+const (
+	SyncServiceOn     SyncService = "on"
+	SyncServiceOff    SyncService = "off"
+	SyncServicePaused SyncService = "paused"
+)
+
+// SyncRule is a SyncIQ performance rule, limiting replication while its schedule applies
+type SyncRule struct {
+	ID          string            `json:"id,omitempty"`
+	Type        SyncRuleType      `json:"type"`
+	Limit       int               `json:"limit"`
+	Enabled     bool              `json:"enabled"`
+	Description string            `json:"description,omitempty"`
+	Schedule    *SyncRuleSchedule `json:"schedule,omitempty"`
+}
+
+// SyncRuleSchedule is the time of day, given as "HH:MM", and the days of the week a rule applies
+type SyncRuleSchedule struct {
+	Begin     string `json:"begin"`
+	End       string `json:"end"`
+	Monday    bool   `json:"monday"`
+	Tuesday   bool   `json:"tuesday"`
+	Wednesday bool   `json:"wednesday"`
+	Thursday  bool   `json:"thursday"`
+	Friday    bool   `json:"friday"`
+	Saturday  bool   `json:"saturday"`
+	Sunday    bool   `json:"sunday"`
+}
+
+// NewSyncRuleSchedule returns a schedule applying between begin and end on the given days
+func NewSyncRuleSchedule(begin, end string, days ...time.Weekday) *SyncRuleSchedule {
+	schedule := &SyncRuleSchedule{Begin: begin, End: end}
+	for _, day := range days {
+		switch day {
+		case time.Monday:
+			schedule.Monday = true
+		case time.Tuesday:
+			schedule.Tuesday = true
+		case time.Wednesday:
+			schedule.Wednesday = true
+		case time.Thursday:
+			schedule.Thursday = true
+		case time.Friday:
+			schedule.Friday = true
+		case time.Saturday:
+			schedule.Saturday = true
+		case time.Sunday:
+			schedule.Sunday = true
+		}
+	}
+	return schedule
+}
+
+// SyncRuleUpdate modifies a SyncIQ performance rule, nil fields are left unchanged
+type SyncRuleUpdate struct {
+	Limit       *int              `json:"limit,omitempty"`
+	Enabled     *bool             `json:"enabled,omitempty"`
+	Description *string           `json:"description,omitempty"`
+	Schedule    *SyncRuleSchedule `json:"schedule,omitempty"`
+}
+
+type SyncRules struct {
+	Rules  []SyncRule `json:"rules,omitempty"`
+	Resume string     `json:"resume,omitempty"`
+	Total  int64      `json:"total,omitempty"`
+}
+
+// SyncSettings holds the global SyncIQ settings
+type SyncSettings struct {
+	Service                        SyncService          `json:"service,omitempty"`
+	EncryptionRequired             bool                 `json:"encryption_required"`
+	EncryptionCipherList           string               `json:"encryption_cipher_list,omitempty"`
+	ClusterCertificateID           string               `json:"cluster_certificate_id,omitempty"`
+	ReportMaxAge                   int                  `json:"report_max_age,omitempty"`
+	ReportMaxCount                 int                  `json:"report_max_count,omitempty"`
+	ReportEmail                    []string             `json:"report_email,omitempty"`
+	BandwidthReservationAbsolute   int                  `json:"bandwidth_reservation_reserve_absolute,omitempty"`
+	BandwidthReservationPercentage int                  `json:"bandwidth_reservation_reserve_percentage,omitempty"`
+	MaxConcurrentJobs              int                  `json:"max_concurrent_jobs,omitempty"`
+	RPOAlerts                      bool                 `json:"rpo_alerts"`
+	PreferredRPOAlert              int                  `json:"preferred_rpo_alert,omitempty"`
+	RestrictTargetNetwork          bool                 `json:"restrict_target_network"`
+	ForceInterface                 bool                 `json:"force_interface"`
+	SourceNetwork                  *PolicySourceNetwork `json:"source_network,omitempty"`
+	UseWorkersPerNode              bool                 `json:"use_workers_per_node"`
+}
+
+type SyncSettingsResp struct {
+	Settings *SyncSettings `json:"settings"`
+}
+
+// SyncSettingsUpdate modifies the global SyncIQ settings, nil fields are left unchanged
+type SyncSettingsUpdate struct {
+	Service                        *SyncService         `json:"service,omitempty"`
+	EncryptionRequired             *bool                `json:"encryption_required,omitempty"`
+	EncryptionCipherList           *string              `json:"encryption_cipher_list,omitempty"`
+	ClusterCertificateID           *string              `json:"cluster_certificate_id,omitempty"`
+	ReportMaxAge                   *int                 `json:"report_max_age,omitempty"`
+	ReportMaxCount                 *int                 `json:"report_max_count,omitempty"`
+	ReportEmail                    *[]string            `json:"report_email,omitempty"`
+	BandwidthReservationAbsolute   *int                 `json:"bandwidth_reservation_reserve_absolute,omitempty"`
+	BandwidthReservationPercentage *int                 `json:"bandwidth_reservation_reserve_percentage,omitempty"`
+	MaxConcurrentJobs              *int                 `json:"max_concurrent_jobs,omitempty"`
+	RPOAlerts                      *bool                `json:"rpo_alerts,omitempty"`
+	PreferredRPOAlert              *int                 `json:"preferred_rpo_alert,omitempty"`
+	RestrictTargetNetwork          *bool                `json:"restrict_target_network,omitempty"`
+	ForceInterface                 *bool                `json:"force_interface,omitempty"`
+	SourceNetwork                  *PolicySourceNetwork `json:"source_network,omitempty"`
+	UseWorkersPerNode              *bool                `json:"use_workers_per_node,omitempty"`
+}
+
+// GetSyncRules returns the SyncIQ performance rules, of the given type or of all types if ruleType is empty
+func GetSyncRules(ctx context.Context, client api.Client, ruleType SyncRuleType) ([]SyncRule, error) {
+	var rules []SyncRule
+	var params api.OrderedValues
+	if ruleType != "" {
+		params = api.OrderedValues{
+			{[]byte("type"), []byte(ruleType)},
+		}
+	}
+	for {
+		r := &SyncRules{}
+		err := client.Get(ctx, syncRulesPath, "", params, nil, &r)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, r.Rules...)
+		if r.Resume == "" {
+			break
+		}
+		params = api.OrderedValues{
+			{[]byte("resume"), []byte(r.Resume)},
+		}
+	}
+	return rules, nil
+}
+
+// GetSyncRule returns a SyncIQ performance rule by ID
+func GetSyncRule(ctx context.Context, client api.Client, id string) (*SyncRule, error) {
+	r := &SyncRules{}
+	err := client.Get(ctx, syncRulesPath, id, nil, nil, &r)
+	if err != nil {
+		return nil, err
+	}
+	if len(r.Rules) == 0 {
+		return nil, fmt.Errorf("successful code returned, but rule %s not found", id)
+	}
+	return &r.Rules[0], nil
+}
+
+// CreateSyncRule creates a SyncIQ performance rule and returns its ID
+func CreateSyncRule(ctx context.Context, client api.Client, rule *SyncRule) (string, error) {
+	if rule == nil {
+		return "", errors.New("no rule set")
+	}
+	if err := validateSyncRuleSchedule(rule.Schedule); err != nil {
+		return "", err
+	}
+	body := *rule
+	body.ID = ""
+	var resp SyncRule
+	if err := client.Post(ctx, syncRulesPath, "", nil, nil, &body, &resp); err != nil {
+		return "", err
+	}
+	return resp.ID, nil
+}
+
+// UpdateSyncRule modifies the fields of a SyncIQ performance rule that are set in update
+func UpdateSyncRule(ctx context.Context, client api.Client, id string, update *SyncRuleUpdate) error {
+	if update == nil {
+		return errors.New("no rule update set")
+	}
+	if err := validateSyncRuleSchedule(update.Schedule); err != nil {
+		return err
+	}
+	return client.Put(ctx, syncRulesPath, id, nil, nil, update, nil)
+}
+
+// DeleteSyncRule deletes a SyncIQ performance rule
+func DeleteSyncRule(ctx context.Context, client api.Client, id string) error {
+	return client.Delete(ctx, syncRulesPath, id, nil, nil, nil)
+}
+
+func validateSyncRuleSchedule(schedule *SyncRuleSchedule) error {
+	if schedule == nil {
+		return nil
+	}
+	for _, t := range []string{schedule.Begin, schedule.End} {
+		if _, err := time.Parse("15:04", t); err != nil {
+			return fmt.Errorf("invalid rule schedule time %q, expected HH:MM", t)
+		}
+	}
+	return nil
+}
+
+// GetSyncSettings returns the global SyncIQ settings
+func GetSyncSettings(ctx context.Context, client api.Client) (*SyncSettings, error) {
+	resp := &SyncSettingsResp{}
+	err := client.Get(ctx, syncSettingsPath, "", nil, nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Settings == nil {
+		return nil, errors.New("no sync settings returned")
+	}
+	return resp.Settings, nil
+}
+
+// UpdateSyncSettings modifies the global SyncIQ settings that are set in update
+func UpdateSyncSettings(ctx context.Context, client api.Client, update *SyncSettingsUpdate) error {
+	if update == nil {
+		return errors.New("no sync settings update set")
+	}
+	return client.Put(ctx, syncSettingsPath, "", nil, nil, update, nil)
+}
@@ -0,0 +1,144 @@
+/*
+Copyright (c) 2025 Dell Inc, or its subsidiaries.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v11
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dell/goisilon/api"
+)
+
+const syncCertificatesPath = "/platform/11/sync/certificates/%s/"
+
+// SyncCertificateKind selects the SyncIQ certificate store
+type SyncCertificateKind string
+
+const (
+	// ServerCertificate is a certificate identifying this cluster to its peers
+	ServerCertificate SyncCertificateKind = "server"
+	// PeerCertificate is a trusted certificate of a peer cluster
+	PeerCertificate SyncCertificateKind = "peer"
+)
+
+// SyncCertificate is a SyncIQ server or peer certificate
+type SyncCertificate struct {
+	ID           string                   `json:"id"`
+	Name         string                   `json:"name,omitempty"`
+	Description  string                   `json:"description,omitempty"`
+	Subject      string                   `json:"subject,omitempty"`
+	Issuer       string                   `json:"issuer,omitempty"`
+	Status       string                   `json:"status,omitempty"`
+	NotBefore    int64                    `json:"not_before,omitempty"`
+	NotAfter     int64                    `json:"not_after,omitempty"`
+	Fingerprints []CertificateFingerprint `json:"fingerprints,omitempty"`
+}
+
+type CertificateFingerprint struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// ExpiresBefore reports whether the certificate is no longer valid at t
+func (c *SyncCertificate) ExpiresBefore(t time.Time) bool {
+	return c.NotAfter != 0 && time.Unix(c.NotAfter, 0).Before(t)
+}
+
+type SyncCertificates struct {
+	Certificates []SyncCertificate `json:"certificates,omitempty"`
+	Resume       string            `json:"resume,omitempty"`
+	Total        int64             `json:"total,omitempty"`
+}
+
+// SyncCertificateImport imports a certificate from PEM files stored on the cluster.
+// The key is only used for server certificates.
+type SyncCertificateImport struct {
+	CertificatePath        string `json:"certificate_path"`
+	CertificateKeyPath     string `json:"certificate_key_path,omitempty"`
+	CertificateKeyPassword string `json:"certificate_key_password,omitempty"`
+	Name                   string `json:"name,omitempty"`
+	Description            string `json:"description,omitempty"`
+}
+
+// SyncCertificateUpdate modifies a SyncIQ certificate, nil fields are left unchanged
+type SyncCertificateUpdate struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// GetSyncCertificates returns the SyncIQ certificates of the given kind
+func GetSyncCertificates(ctx context.Context, client api.Client, kind SyncCertificateKind) ([]SyncCertificate, error) {
+	var certificates []SyncCertificate
+	var params api.OrderedValues
+	for {
+		c := &SyncCertificates{}
+		err := client.Get(ctx, fmt.Sprintf(syncCertificatesPath, kind), "", params, nil, &c)
+		if err != nil {
+			return nil, err
+		}
+		certificates = append(certificates, c.Certificates...)
+		if c.Resume == "" {
+			break
+		}
+		params = api.OrderedValues{
+			{[]byte("resume"), []byte(c.Resume)},
+		}
+	}
+	return certificates, nil
+}
+
+// GetSyncCertificate returns a SyncIQ certificate of the given kind by ID
+func GetSyncCertificate(ctx context.Context, client api.Client, kind SyncCertificateKind, id string) (*SyncCertificate, error) {
+	c := &SyncCertificates{}
+	err := client.Get(ctx, fmt.Sprintf(syncCertificatesPath, kind), id, nil, nil, &c)
+	if err != nil {
+		return nil, err
+	}
+	if len(c.Certificates) == 0 {
+		return nil, fmt.Errorf("successful code returned, but %s certificate %s not found", kind, id)
+	}
+	return &c.Certificates[0], nil
+}
+
+// ImportSyncCertificate imports a SyncIQ certificate of the given kind and returns its ID
+func ImportSyncCertificate(ctx context.Context, client api.Client, kind SyncCertificateKind, req *SyncCertificateImport) (string, error) {
+	if req == nil || req.CertificatePath == "" {
+		return "", errors.New("no certificate path set")
+	}
+	if kind == ServerCertificate && req.CertificateKeyPath == "" {
+		return "", errors.New("a server certificate requires a key path")
+	}
+	var resp SyncCertificate
+	if err := client.Post(ctx, fmt.Sprintf(syncCertificatesPath, kind), "", nil, nil, req, &resp); err != nil {
+		return "", err
+	}
+	return resp.ID, nil
+}
+
+// UpdateSyncCertificate modifies the name or description of a SyncIQ certificate
+func UpdateSyncCertificate(ctx context.Context, client api.Client, kind SyncCertificateKind, id string, update *SyncCertificateUpdate) error {
+	if update == nil {
+		return errors.New("no certificate update set")
+	}
+	return client.Put(ctx, fmt.Sprintf(syncCertificatesPath, kind), id, nil, nil, update, nil)
+}
+
+// DeleteSyncCertificate deletes a SyncIQ certificate of the given kind
+func DeleteSyncCertificate(ctx context.Context, client api.Client, kind SyncCertificateKind, id string) error {
+	return client.Delete(ctx, fmt.Sprintf(syncCertificatesPath, kind), id, nil, nil, nil)
+}
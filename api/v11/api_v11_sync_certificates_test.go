@@ -0,0 +1,88 @@
+/*
+Copyright (c) 2025 Dell Inc, or its subsidiaries.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v11
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dell/goisilon/api"
+	"github.com/dell/goisilon/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetSyncCertificates(t *testing.T) {
+	ctx := context.Background()
+	client := &mocks.Client{}
+
+	client.On("Get", ctx, "/platform/11/sync/certificates/peer/", "", api.OrderedValues(nil), mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(**SyncCertificates)
+		*resp = &SyncCertificates{Certificates: []SyncCertificate{{ID: "a"}}, Resume: "next"}
+	}).Once()
+	client.On("Get", ctx, "/platform/11/sync/certificates/peer/", "", api.OrderedValues{{[]byte("resume"), []byte("next")}}, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(**SyncCertificates)
+		*resp = &SyncCertificates{Certificates: []SyncCertificate{{ID: "b"}}}
+	}).Once()
+	certificates, err := GetSyncCertificates(ctx, client, PeerCertificate)
+	assert.NoError(t, err)
+	assert.Equal(t, []SyncCertificate{{ID: "a"}, {ID: "b"}}, certificates)
+
+	client.On("Get", ctx, "/platform/11/sync/certificates/server/", "c", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	_, err = GetSyncCertificate(ctx, client, ServerCertificate, "c")
+	assert.Equal(t, errors.New("successful code returned, but server certificate c not found"), err)
+}
+
+func TestImportSyncCertificate(t *testing.T) {
+	ctx := context.Background()
+	client := &mocks.Client{}
+
+	_, err := ImportSyncCertificate(ctx, client, PeerCertificate, &SyncCertificateImport{})
+	assert.Equal(t, errors.New("no certificate path set"), err)
+	_, err = ImportSyncCertificate(ctx, client, ServerCertificate, &SyncCertificateImport{CertificatePath: "/ifs/cert.pem"})
+	assert.Equal(t, errors.New("a server certificate requires a key path"), err)
+
+	req := &SyncCertificateImport{CertificatePath: "/ifs/cert.pem", Name: "peer"}
+	client.On("Post", ctx, "/platform/11/sync/certificates/peer/", "", mock.Anything, mock.Anything, req, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		args.Get(6).(*SyncCertificate).ID = "abc"
+	}).Once()
+	id, err := ImportSyncCertificate(ctx, client, PeerCertificate, req)
+	assert.NoError(t, err)
+	assert.Equal(t, "abc", id)
+}
+
+func TestUpdateAndDeleteSyncCertificate(t *testing.T) {
+	ctx := context.Background()
+	client := &mocks.Client{}
+
+	err := UpdateSyncCertificate(ctx, client, PeerCertificate, "abc", nil)
+	assert.Equal(t, errors.New("no certificate update set"), err)
+	name := "renamed"
+	client.On("Put", ctx, "/platform/11/sync/certificates/peer/", "abc", mock.Anything, mock.Anything, &SyncCertificateUpdate{Name: &name}, mock.Anything).Return(nil).Once()
+	assert.NoError(t, UpdateSyncCertificate(ctx, client, PeerCertificate, "abc", &SyncCertificateUpdate{Name: &name}))
+
+	client.On("Delete", ctx, "/platform/11/sync/certificates/peer/", "abc", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	assert.NoError(t, DeleteSyncCertificate(ctx, client, PeerCertificate, "abc"))
+}
+
+func TestSyncCertificateExpiresBefore(t *testing.T) {
+	now := time.Now()
+	assert.True(t, (&SyncCertificate{NotAfter: now.Add(-time.Hour).Unix()}).ExpiresBefore(now))
+	assert.False(t, (&SyncCertificate{NotAfter: now.Add(time.Hour).Unix()}).ExpiresBefore(now))
+	assert.False(t, (&SyncCertificate{}).ExpiresBefore(now))
+}
@@ -0,0 +1,103 @@
+/*
+Copyright (c) 2025 Dell Inc, or its subsidiaries.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v11
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dell/goisilon/api"
+	"github.com/dell/goisilon/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewSyncRuleSchedule(t *testing.T) {
+	schedule := NewSyncRuleSchedule("08:00", "18:00", time.Monday, time.Friday)
+	assert.Equal(t, &SyncRuleSchedule{Begin: "08:00", End: "18:00", Monday: true, Friday: true}, schedule)
+}
+
+func TestGetSyncRules(t *testing.T) {
+	ctx := context.Background()
+	client := &mocks.Client{}
+
+	client.On("Get", ctx, syncRulesPath, "", api.OrderedValues{{[]byte("type"), []byte("bandwidth")}}, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(**SyncRules)
+		*resp = &SyncRules{Rules: []SyncRule{{ID: "bw-0"}}, Resume: "next"}
+	}).Once()
+	client.On("Get", ctx, syncRulesPath, "", api.OrderedValues{{[]byte("resume"), []byte("next")}}, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(**SyncRules)
+		*resp = &SyncRules{Rules: []SyncRule{{ID: "bw-1"}}}
+	}).Once()
+	rules, err := GetSyncRules(ctx, client, BandwidthRule)
+	assert.NoError(t, err)
+	assert.Equal(t, []SyncRule{{ID: "bw-0"}, {ID: "bw-1"}}, rules)
+
+	client.On("Get", ctx, syncRulesPath, "bw-2", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	_, err = GetSyncRule(ctx, client, "bw-2")
+	assert.Equal(t, errors.New("successful code returned, but rule bw-2 not found"), err)
+}
+
+func TestCreateUpdateDeleteSyncRule(t *testing.T) {
+	ctx := context.Background()
+	client := &mocks.Client{}
+
+	_, err := CreateSyncRule(ctx, client, nil)
+	assert.Equal(t, errors.New("no rule set"), err)
+	_, err = CreateSyncRule(ctx, client, &SyncRule{Type: BandwidthRule, Schedule: NewSyncRuleSchedule("8am", "18:00")})
+	assert.Equal(t, errors.New(`invalid rule schedule time "8am", expected HH:MM`), err)
+
+	client.On("Post", ctx, syncRulesPath, "", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		args.Get(6).(*SyncRule).ID = "bw-0"
+	}).Once()
+	id, err := CreateSyncRule(ctx, client, &SyncRule{Type: BandwidthRule, Limit: 10000, Enabled: true, Schedule: NewSyncRuleSchedule("08:00", "18:00", time.Monday)})
+	assert.NoError(t, err)
+	assert.Equal(t, "bw-0", id)
+
+	err = UpdateSyncRule(ctx, client, "bw-0", nil)
+	assert.Equal(t, errors.New("no rule update set"), err)
+	limit := 5000
+	client.On("Put", ctx, syncRulesPath, "bw-0", mock.Anything, mock.Anything, &SyncRuleUpdate{Limit: &limit}, mock.Anything).Return(nil).Once()
+	assert.NoError(t, UpdateSyncRule(ctx, client, "bw-0", &SyncRuleUpdate{Limit: &limit}))
+
+	client.On("Delete", ctx, syncRulesPath, "bw-0", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	assert.NoError(t, DeleteSyncRule(ctx, client, "bw-0"))
+}
+
+func TestSyncSettings(t *testing.T) {
+	ctx := context.Background()
+	client := &mocks.Client{}
+
+	client.On("Get", ctx, syncSettingsPath, "", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(**SyncSettingsResp)
+		*resp = &SyncSettingsResp{Settings: &SyncSettings{Service: SyncServiceOn, EncryptionRequired: true}}
+	}).Once()
+	settings, err := GetSyncSettings(ctx, client)
+	assert.NoError(t, err)
+	assert.Equal(t, SyncServiceOn, settings.Service)
+
+	client.On("Get", anyArgs[:6]...).Return(nil).Once()
+	_, err = GetSyncSettings(ctx, client)
+	assert.Equal(t, errors.New("no sync settings returned"), err)
+
+	err = UpdateSyncSettings(ctx, client, nil)
+	assert.Equal(t, errors.New("no sync settings update set"), err)
+	service := SyncServicePaused
+	client.On("Put", ctx, syncSettingsPath, "", mock.Anything, mock.Anything, &SyncSettingsUpdate{Service: &service}, mock.Anything).Return(nil).Once()
+	assert.NoError(t, UpdateSyncSettings(ctx, client, &SyncSettingsUpdate{Service: &service}))
+}
@@ -0,0 +1,111 @@
+/*
+Copyright (c) 2025 Dell Inc, or its subsidiaries.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v14
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/dell/goisilon/api"
+)
+
+// writableSnapshotID returns the resource ID of a writable snapshot, which is its path without the leading slash.
+func writableSnapshotID(dstPath string) string {
+	return strings.TrimPrefix(dstPath, "/")
+}
+
+// CreateIsiWritableSnapshot creates a writable snapshot of an existing snapshot mounted at dstPath
+func CreateIsiWritableSnapshot(
+	ctx context.Context,
+	client api.Client,
+	srcSnap, dstPath string,
+) (*IsiWritableSnapshot, error) {
+	// PAPI call: POST https://1.2.3.4:8080/platform/14/snapshot/writable
+	//            Content-Type: application/json
+	//            {src_snap: "snapshot_name", dst_path: "/ifs/path/to/clone"}
+	if srcSnap == "" {
+		return nil, errors.New("no source snapshot set")
+	}
+	if dstPath == "" {
+		return nil, errors.New("no destination path set")
+	}
+	var resp IsiWritableSnapshot
+	err := client.Post(ctx, writableSnapshotsPath, "", nil, nil,
+		&IsiWritableSnapshotReq{SrcSnap: srcSnap, DstPath: dstPath}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	if resp.DstPath == "" {
+		resp.DstPath = dstPath
+	}
+	return &resp, nil
+}
+
+// GetIsiWritableSnapshots queries a list of all writable snapshots on the cluster
+func GetIsiWritableSnapshots(
+	ctx context.Context,
+	client api.Client,
+) ([]*IsiWritableSnapshot, error) {
+	// PAPI call: GET https://1.2.3.4:8080/platform/14/snapshot/writable
+	var writable []*IsiWritableSnapshot
+	var params api.OrderedValues
+	for {
+		var resp IsiWritableSnapshotsResp
+		err := client.Get(ctx, writableSnapshotsPath, "", params, nil, &resp)
+		if err != nil {
+			return nil, err
+		}
+		writable = append(writable, resp.Writable...)
+		if resp.Resume == "" {
+			break
+		}
+		params = api.OrderedValues{
+			{[]byte("resume"), []byte(resp.Resume)},
+		}
+	}
+	return writable, nil
+}
+
+// GetIsiWritableSnapshot queries the writable snapshot mounted at dstPath
+func GetIsiWritableSnapshot(
+	ctx context.Context,
+	client api.Client,
+	dstPath string,
+) (*IsiWritableSnapshot, error) {
+	// PAPI call: GET https://1.2.3.4:8080/platform/14/snapshot/writable/ifs/path/to/clone
+	var resp IsiWritableSnapshotsResp
+	err := client.Get(ctx, writableSnapshotsPath, writableSnapshotID(dstPath), nil, nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Writable) == 0 {
+		return nil, fmt.Errorf("writable snapshot %s not found", dstPath)
+	}
+	return resp.Writable[0], nil
+}
+
+// RemoveIsiWritableSnapshot deletes the writable snapshot mounted at dstPath
+func RemoveIsiWritableSnapshot(
+	ctx context.Context,
+	client api.Client,
+	dstPath string,
+) error {
+	// PAPI call: DELETE https://1.2.3.4:8080/platform/14/snapshot/writable/ifs/path/to/clone
+	return client.Delete(ctx, writableSnapshotsPath, writableSnapshotID(dstPath), nil, nil, nil)
+}
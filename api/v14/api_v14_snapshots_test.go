@@ -0,0 +1,82 @@
+/*
+Copyright (c) 2025 Dell Inc, or its subsidiaries.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v14
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/dell/goisilon/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateIsiWritableSnapshot(t *testing.T) {
+	ctx := context.Background()
+	client := &mocks.Client{}
+
+	_, err := CreateIsiWritableSnapshot(ctx, client, "", "/ifs/data/clone")
+	assert.Equal(t, errors.New("no source snapshot set"), err)
+	_, err = CreateIsiWritableSnapshot(ctx, client, "snap", "")
+	assert.Equal(t, errors.New("no destination path set"), err)
+
+	client.On("Post", ctx, "platform/14/snapshot/writable", "", mock.Anything, mock.Anything,
+		&IsiWritableSnapshotReq{SrcSnap: "snap", DstPath: "/ifs/data/clone"}, mock.Anything).Return(nil).Once()
+	writable, err := CreateIsiWritableSnapshot(ctx, client, "snap", "/ifs/data/clone")
+	assert.Nil(t, err)
+	assert.Equal(t, "/ifs/data/clone", writable.DstPath)
+
+	client.On("Post", anyArgs...).Return(errors.New("error")).Once()
+	_, err = CreateIsiWritableSnapshot(ctx, client, "snap", "/ifs/data/clone")
+	assert.Error(t, err)
+}
+
+func TestGetIsiWritableSnapshots(t *testing.T) {
+	ctx := context.Background()
+	client := &mocks.Client{}
+
+	client.On("Get", anyArgs[:6]...).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*IsiWritableSnapshotsResp)
+		resp.Writable = []*IsiWritableSnapshot{{DstPath: "/ifs/data/clone1"}}
+		resp.Resume = "resume"
+	}).Once()
+	client.On("Get", anyArgs[:6]...).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*IsiWritableSnapshotsResp)
+		resp.Writable = []*IsiWritableSnapshot{{DstPath: "/ifs/data/clone2"}}
+	}).Once()
+	writable, err := GetIsiWritableSnapshots(ctx, client)
+	assert.Nil(t, err)
+	assert.Len(t, writable, 2)
+
+	client.On("Get", anyArgs[:6]...).Return(errors.New("error")).Once()
+	_, err = GetIsiWritableSnapshots(ctx, client)
+	assert.Error(t, err)
+}
+
+func TestGetAndRemoveIsiWritableSnapshot(t *testing.T) {
+	ctx := context.Background()
+	client := &mocks.Client{}
+
+	client.On("Get", ctx, "platform/14/snapshot/writable", "ifs/data/clone", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	_, err := GetIsiWritableSnapshot(ctx, client, "/ifs/data/clone")
+	assert.Equal(t, errors.New("writable snapshot /ifs/data/clone not found"), err)
+
+	client.On("Delete", ctx, "platform/14/snapshot/writable", "ifs/data/clone", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	err = RemoveIsiWritableSnapshot(ctx, client, "/ifs/data/clone")
+	assert.Nil(t, err)
+}
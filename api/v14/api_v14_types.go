@@ -31,3 +31,40 @@ type IsiClusterAcs struct {
 	// list of unresponsive nodes serial number.
 	UnresponsiveSn []string `json:"unresponsive_sn,omitempty"`
 }
+
+// IsiWritableSnapshot Writable snapshot information.
+type IsiWritableSnapshot struct {
+	// The Unix Epoch time the writable snapshot was created.
+	Created int64 `json:"created,omitempty"`
+	// The directory path at which the writable snapshot is mounted.
+	DstPath string `json:"dst_path"`
+	// The system-assigned ID of the writable snapshot.
+	ID int64 `json:"id,omitempty"`
+	// The amount of storage in bytes used to track changes made to the writable snapshot.
+	LogSize int64 `json:"log_size,omitempty"`
+	// The amount of physical storage in bytes used by the writable snapshot.
+	PhysSize int64 `json:"phys_size,omitempty"`
+	// The ID of the snapshot the writable snapshot was created from.
+	SnapID int64 `json:"snap_id,omitempty"`
+	// The name of the snapshot the writable snapshot was created from.
+	SnapName string `json:"snap_name,omitempty"`
+	// The path of the snapshot the writable snapshot was created from.
+	SrcPath string `json:"src_path,omitempty"`
+	// The state of the writable snapshot, e.g. active or deleting.
+	State string `json:"state,omitempty"`
+}
+
+// IsiWritableSnapshotReq Properties of a writable snapshot to create.
+type IsiWritableSnapshotReq struct {
+	// The directory path at which to mount the writable snapshot, it must not exist.
+	DstPath string `json:"dst_path"`
+	// The name or ID of the snapshot to create the writable snapshot from.
+	SrcSnap string `json:"src_snap"`
+}
+
+// IsiWritableSnapshotsResp List of writable snapshots.
+type IsiWritableSnapshotsResp struct {
+	Resume   string                 `json:"resume,omitempty"`
+	Total    int64                  `json:"total,omitempty"`
+	Writable []*IsiWritableSnapshot `json:"writable"`
+}
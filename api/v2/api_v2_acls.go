@@ -208,6 +208,35 @@ func ParseFileMode(s string) (FileMode, error) {
 	return fm, nil
 }
 
+const (
+	// ACEAccessTypeAllow is an ACE's AccessType granting its rights.
+	ACEAccessTypeAllow = "allow"
+
+	// ACEAccessTypeDeny is an ACE's AccessType denying its rights.
+	ACEAccessTypeDeny = "deny"
+
+	// ACEOpAdd adds an ACE to an ACL updated with ActionTypeUpdate.
+	ACEOpAdd = "add"
+
+	// ACEOpDelete deletes an ACE from an ACL updated with ActionTypeUpdate.
+	ACEOpDelete = "delete"
+
+	// ACEOpReplace replaces the rights of an ACE of an ACL updated with
+	// ActionTypeUpdate.
+	ACEOpReplace = "replace"
+)
+
+// ACE is an access control entry of an ACL, granting or denying rights such
+// as "file_read" or "dir_gen_all" to a trustee.
+type ACE struct {
+	Trustee      *Persona `json:"trustee,omitempty"`
+	AccessType   string   `json:"accesstype,omitempty"`
+	AccessRights []string `json:"accessrights,omitempty"`
+	InheritFlags []string `json:"inherit_flags,omitempty"`
+	// Op is only used to update an ACL, see ACEOpAdd.
+	Op string `json:"op,omitempty"`
+}
+
 // ACL is an Isilon Access Control List used for managing an object's security.
 type ACL struct {
 	Authoritative *AuthoritativeType `json:"authoritative,omitempty"`
@@ -215,6 +244,7 @@ type ACL struct {
 	Owner         *Persona           `json:"owner,omitempty"`
 	Group         *Persona           `json:"group,omitempty"`
 	Mode          *FileMode          `json:"mode,omitempty"`
+	ACL           []*ACE             `json:"acl,omitempty"`
 }
 
 var aclQueryString = api.OrderedValues{{[]byte("acl")}}
@@ -260,3 +290,21 @@ func ACLUpdate(
 
 	return nil
 }
+
+// ACLUpdateWithIsiPath PUTs the ACL of the file or directory at the absolute
+// path isiPath.
+func ACLUpdateWithIsiPath(
+	ctx context.Context,
+	client api.Client,
+	isiPath string,
+	acl *ACL,
+) error {
+	return client.Put(
+		ctx,
+		namespacePath,
+		strings.TrimPrefix(isiPath, "/"),
+		aclQueryString,
+		nil,
+		acl,
+		nil)
+}
@@ -23,6 +23,7 @@ import (
 
 	"github.com/dell/goisilon/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestACLInspect(t *testing.T) {
@@ -64,6 +65,15 @@ func TestACLUpdate(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestACLUpdateWithIsiPath(t *testing.T) {
+	ctx := context.Background()
+	client := &mocks.Client{}
+	acl := &ACL{Action: &PActionTypeReplace}
+
+	client.On("Put", ctx, "namespace", "ifs/data/vol/dir", aclQueryString, mock.Anything, acl, nil).Return(nil).Once()
+	assert.NoError(t, ACLUpdateWithIsiPath(ctx, client, "/ifs/data/vol/dir", acl))
+}
+
 func TestParseAuthoritativeType(t *testing.T) {
 	authType := ParseAuthoritativeType(authoritativeTypeACLStr)
 	assert.Equal(t, AuthoritativeTypeACL, authType)
@@ -177,3 +187,25 @@ func TestAuthoritativeType_UnmarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestACL_ACEJSON(t *testing.T) {
+	var acl ACL
+	err := json.Unmarshal([]byte(`{"authoritative":"acl","acl":[{
+		"trustee":{"id":"UID:1000","name":"alice","type":"user"},
+		"accesstype":"allow","accessrights":["file_read"],"inherit_flags":["object_inherit"]}]}`), &acl)
+	assert.NoError(t, err)
+	assert.Len(t, acl.ACL, 1)
+	assert.Equal(t, "alice", *acl.ACL[0].Trustee.Name)
+	assert.Equal(t, PersonaID{ID: "1000", Type: PersonaIDTypeUID}, *acl.ACL[0].Trustee.ID)
+	assert.Equal(t, ACEAccessTypeAllow, acl.ACL[0].AccessType)
+
+	data, err := json.Marshal(&ACL{Action: &PActionTypeUpdate, ACL: []*ACE{{
+		Trustee:      &Persona{ID: &PersonaID{ID: "staff", Type: PersonaIDTypeGroup}},
+		AccessType:   ACEAccessTypeDeny,
+		AccessRights: []string{"file_write"},
+		Op:           ACEOpAdd,
+	}}})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"action":"update","acl":[{"trustee":{"id":"group:staff"},
+		"accesstype":"deny","accessrights":["file_write"],"op":"add"}]}`, string(data))
+}
@@ -18,6 +18,7 @@ package v2
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"path"
@@ -36,6 +37,8 @@ type ContainerChild struct {
 	Group *string   `json:"group,omitempty"`
 	Mode  *FileMode `json:"mode,omitempty"`
 	Size  *int      `json:"size,omitempty"`
+	// LastModified is returned when requested in the detail or query result.
+	LastModified *string `json:"last_modified,omitempty"`
 }
 
 type resumeableContainerChildList struct {
@@ -72,17 +75,21 @@ type ContainerQuery struct {
 	Scope  *ContainerQueryScope `json:"scope,omitempty"`
 }
 
-// ContainerQueryScope is the query's scope.
+// ContainerQueryScope is the query's scope. A condition is a
+// *ContainerQueryScopeCondition or a nested *ContainerQueryScope.
 type ContainerQueryScope struct {
 	Logic      string        `json:"logic,omitempty"`
 	Conditions []interface{} `json:"conditions,omitempty"`
 }
 
-// ContainerQueryScopeCondition is the query's condition.
+// ContainerQueryScopeCondition is the query's condition. The value is a
+// string, number or bool as the attribute requires. Set Namespace to "user"
+// to match user-defined metadata.
 type ContainerQueryScopeCondition struct {
-	Operator string `json:"operator,omitempty"`
-	Attr     string `json:"attr,omitempty"`
-	Value    string `json:"value,omitempty"`
+	Operator  string      `json:"operator,omitempty"`
+	Attr      string      `json:"attr,omitempty"`
+	Value     interface{} `json:"value,omitempty"`
+	Namespace string      `json:"namespace,omitempty"`
 }
 
 var containerChildrenGetAllDetail = []string{
@@ -291,6 +298,43 @@ func ContainerChildrenPostQuery(
 	return resp, nil
 }
 
+// ContainerChildrenPostQueryWithIsiPath returns a page of at most limit
+// children of the container at the absolute path isiPath that match query,
+// as ContainerChildrenPostQuery, along with the resume token of the next
+// page, which is empty for the last one. Pass it as resume to get that page.
+func ContainerChildrenPostQueryWithIsiPath(
+	ctx context.Context,
+	client api.Client,
+	isiPath string,
+	limit, maxDepth int,
+	query *ContainerQuery,
+	resume string,
+) (ContainerChildList, string, error) {
+	if query == nil {
+		return nil, "", errors.New("no container query set")
+	}
+	qs := api.OrderedValues{
+		{queryByteArr},
+		{limitByteArr, []byte(fmt.Sprintf("%d", limit))},
+		{maxDepthByteArr, []byte(fmt.Sprintf("%d", maxDepth))},
+	}
+	if resume != "" {
+		qs = append(qs, [][]byte{resumeByteArr, []byte(resume)})
+	}
+	var resp resumeableContainerChildList
+	if err := client.Post(
+		ctx,
+		namespacePath,
+		strings.TrimPrefix(isiPath, "/"),
+		qs,
+		nil,
+		query,
+		&resp); err != nil {
+		return nil, "", err
+	}
+	return resp.Children, resp.Resume, nil
+}
+
 var (
 	contCreateTTQueryString = api.OrderedValues{
 		{recursiveByteArr, trueByteArr},
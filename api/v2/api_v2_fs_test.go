@@ -22,8 +22,10 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/dell/goisilon/api"
 	"github.com/dell/goisilon/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestContainerChildList_MarshalJSON(t *testing.T) {
@@ -192,6 +194,35 @@ func TestContainerChildrenPostQuery(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestContainerChildrenPostQueryWithIsiPath(t *testing.T) {
+	ctx := context.Background()
+	client := &mocks.Client{}
+	query := &ContainerQuery{Result: []string{"name"}, Scope: &ContainerQueryScope{
+		Logic:      "and",
+		Conditions: []interface{}{&ContainerQueryScopeCondition{Operator: ">", Attr: "size", Value: 10}},
+	}}
+
+	qs := api.OrderedValues{{queryByteArr}, {limitByteArr, []byte("10")}, {maxDepthByteArr, []byte("1")}, {resumeByteArr, []byte("next")}}
+	client.On("Post", ctx, "namespace", "ifs/data", qs, mock.Anything, query, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(6).(*resumeableContainerChildList)
+		name := "a"
+		resp.Children = []*ContainerChild{{Name: &name}}
+		resp.Resume = "last"
+	}).Once()
+	children, resume, err := ContainerChildrenPostQueryWithIsiPath(ctx, client, "/ifs/data", 10, 1, query, "next")
+	assert.NoError(t, err)
+	assert.Len(t, children, 1)
+	assert.Equal(t, "last", resume)
+
+	_, _, err = ContainerChildrenPostQueryWithIsiPath(ctx, client, "/ifs/data", 10, 1, nil, "")
+	assert.EqualError(t, err, "no container query set")
+
+	// typed values and namespaces are sent as they are
+	data, err := json.Marshal(&ContainerQueryScopeCondition{Operator: "=", Attr: "tier", Value: "gold", Namespace: "user"})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"operator":"=","attr":"tier","value":"gold","namespace":"user"}`, string(data))
+}
+
 func TestContainerCreateDir(t *testing.T) {
 	client := &mocks.Client{}
 	client.On("VolumesPath", anyArgs...).Return(testVolumePath).Once()
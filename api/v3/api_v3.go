@@ -25,6 +25,7 @@ const (
 	clusterConfigPath   = "platform/3/cluster/config"
 	clusterIdentityPath = "platform/3/cluster/identity"
 	clusterNodesPath    = "platform/3/cluster/nodes"
+	fsaResultsPath      = "platform/3/fsa/results"
 )
 
 var (
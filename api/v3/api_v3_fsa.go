@@ -0,0 +1,65 @@
+/*
+Copyright (c) 2025 Dell Inc, or its subsidiaries.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v3
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"github.com/dell/goisilon/api"
+)
+
+// GetIsiFsaResults lists the results of the File System Analytics jobs
+func GetIsiFsaResults(
+	ctx context.Context,
+	client api.Client,
+) ([]*IsiFsaResult, error) {
+	// PAPI call: GET https://1.2.3.4:8080/platform/3/fsa/results
+	var resp IsiFsaResults
+	if err := client.Get(ctx, fsaResultsPath, "", nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Results, nil
+}
+
+// GetIsiFsaDirectory returns the usage of the directory tree at the absolute
+// path isiPath as computed by the FSA result resultID
+func GetIsiFsaDirectory(
+	ctx context.Context,
+	client api.Client,
+	resultID int,
+	isiPath string,
+) (*IsiFsaDirectory, error) {
+	// PAPI call: GET https://1.2.3.4:8080/platform/3/fsa/results/{id}/directories?path=/parent/of/dir
+	// This lists the usage of the child directories of the parent, among which is the directory
+	isiPath = path.Clean(isiPath)
+	name := path.Base(isiPath)
+	values := api.OrderedValues{}
+	values.StringAdd("path", path.Dir(isiPath))
+
+	var resp IsiFsaDirectories
+	if err := client.Get(ctx, fmt.Sprintf("%s/%d/directories", fsaResultsPath, resultID), "", values, nil, &resp); err != nil {
+		return nil, err
+	}
+	for _, dir := range resp.Directories {
+		if dir.Name == name {
+			return dir, nil
+		}
+	}
+	return nil, fmt.Errorf("directory not found in FSA result %d: %s", resultID, isiPath)
+}
@@ -0,0 +1,63 @@
+/*
+Copyright (c) 2025 Dell Inc, or its subsidiaries.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v3
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/dell/goisilon/api"
+	"github.com/dell/goisilon/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetIsiFsaResults(t *testing.T) {
+	ctx := context.Background()
+	client := &mocks.Client{}
+
+	client.On("Get", ctx, fsaResultsPath, "", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*IsiFsaResults)
+		resp.Results = []*IsiFsaResult{{ID: 3, EndTime: 100}}
+	}).Once()
+	results, err := GetIsiFsaResults(ctx, client)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, results[0].ID)
+
+	client.On("Get", anyArgs[:6]...).Return(errors.New("error in get fsa results")).Once()
+	_, err = GetIsiFsaResults(ctx, client)
+	assert.Error(t, err)
+}
+
+func TestGetIsiFsaDirectory(t *testing.T) {
+	ctx := context.Background()
+	client := &mocks.Client{}
+
+	client.On("Get", ctx, "platform/3/fsa/results/3/directories", "", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		params := args.Get(3).(api.OrderedValues)
+		assert.Equal(t, "path=%2Fifs%2Fdata", params.Encode())
+		resp := args.Get(5).(*IsiFsaDirectories)
+		resp.Directories = []*IsiFsaDirectory{{Name: "other"}, {Name: "vol", FileCount: 7, DirCount: 2, LogicalSize: 1024}}
+	}).Twice()
+	dir, err := GetIsiFsaDirectory(ctx, client, 3, "/ifs/data/vol/")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), dir.FileCount)
+
+	_, err = GetIsiFsaDirectory(ctx, client, 3, "/ifs/data/missing")
+	assert.EqualError(t, err, "directory not found in FSA result 3: /ifs/data/missing")
+}
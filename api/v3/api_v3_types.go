@@ -513,3 +513,45 @@ type IsiClusterNodeStatusPowersuppliesSupply struct {
 	// The type of this power supply.
 	Type *string `json:"type,omitempty"`
 }
+
+// IsiFsaResult is the result of a File System Analytics (FSA) job.
+type IsiFsaResult struct {
+	// Unique identifier of the result.
+	ID int `json:"id"`
+	// Unix Epoch time at which the FSA job started.
+	BeginTime int64 `json:"begin_time"`
+	// Unix Epoch time at which the FSA job ended, 0 while it runs.
+	EndTime int64 `json:"end_time"`
+	// Whether the result is pinned against deletion.
+	Pinned bool `json:"pinned"`
+}
+
+// IsiFsaResults is a list of FSA results.
+type IsiFsaResults struct {
+	Results []*IsiFsaResult `json:"results"`
+}
+
+// IsiFsaDirectory holds the usage of a directory tree computed by an FSA job.
+type IsiFsaDirectory struct {
+	// Name of the directory.
+	Name string `json:"name"`
+	// LIN of the directory.
+	Lin int64 `json:"lin"`
+	// LIN of the parent directory.
+	Parent int64 `json:"parent"`
+	// Number of directories in the tree.
+	DirCount int64 `json:"dir_cnt"`
+	// Number of files in the tree.
+	FileCount int64 `json:"file_cnt"`
+	// Number of other entries, e.g. symbolic links, in the tree.
+	OtherCount int64 `json:"other_cnt"`
+	// Sum of the logical sizes of the tree in bytes.
+	LogicalSize int64 `json:"log_size_sum"`
+	// Sum of the physical sizes of the tree in bytes.
+	PhysicalSize int64 `json:"phys_size_sum"`
+}
+
+// IsiFsaDirectories is a list of FSA directory usages.
+type IsiFsaDirectories struct {
+	Directories []*IsiFsaDirectory `json:"directories"`
+}
@@ -0,0 +1,137 @@
+/*
+Copyright (c) 2025 Dell Inc, or its subsidiaries.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package goisilon
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	api "github.com/dell/goisilon/api/v1"
+)
+
+// Bits of ChangelistEntry.ChangeTypes describing how an entry changed
+// between the two snapshots of a changelist.
+const (
+	ChangelistEntryAdded       = 0x1
+	ChangelistEntryRemoved     = 0x2
+	ChangelistEntryPathChanged = 0x4
+	ChangelistEntryModified    = 0x8
+)
+
+// changelistPageSize is the number of changelist entries requested per page.
+const changelistPageSize = 1000
+
+// Changelist represents the list of changes between two Isilon snapshots.
+type Changelist *api.IsiChangelist
+
+// ChangelistEntry represents a file or directory that changed between two Isilon snapshots.
+type ChangelistEntry *api.IsiChangelistEntry
+
+// ChangelistID returns the ID OneFS assigns to the changelist between two snapshots.
+func ChangelistID(olderSnapshotID, newerSnapshotID int64) string {
+	return fmt.Sprintf("%d_%d", olderSnapshotID, newerSnapshotID)
+}
+
+// CreateChangelist runs a ChangelistCreate job computing the changes between
+// two snapshots of the same path and waits for it to finish. onProgress, if
+// set, is called with the running job each time it is polled.
+func (c *Client) CreateChangelist(
+	ctx context.Context,
+	olderSnapshotID, newerSnapshotID int64,
+	onProgress func(Job),
+) (Changelist, error) {
+	older, err := api.GetIsiSnapshot(ctx, c.API, olderSnapshotID)
+	if err != nil {
+		return nil, err
+	}
+	newer, err := api.GetIsiSnapshot(ctx, c.API, newerSnapshotID)
+	if err != nil {
+		return nil, err
+	}
+	if older.Path != newer.Path {
+		return nil, fmt.Errorf("snapshots %d (%s) and %d (%s) are not of the same path",
+			older.ID, older.Path, newer.ID, newer.Path)
+	}
+	if older.Created > newer.Created {
+		return nil, fmt.Errorf("snapshot %d is newer than snapshot %d", older.ID, newer.ID)
+	}
+
+	job, err := c.StartJob(ctx, &api.IsiJobReq{
+		Type: JobTypeChangelistCreate,
+		ChangelistcreateParams: &api.IsiJobChangelistcreateParams{
+			OlderSnapid: older.ID,
+			NewerSnapid: newer.ID,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if _, err = c.WaitForJob(ctx, job.ID, onProgress); err != nil {
+		return nil, err
+	}
+
+	return c.GetChangelist(ctx, ChangelistID(older.ID, newer.ID))
+}
+
+// GetChangelists returns all changelists on the cluster.
+func (c *Client) GetChangelists(ctx context.Context) ([]Changelist, error) {
+	changelists, err := api.GetIsiChangelists(ctx, c.API)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]Changelist, 0, len(changelists))
+	for _, changelist := range changelists {
+		result = append(result, changelist)
+	}
+	return result, nil
+}
+
+// GetChangelist returns a changelist by ID.
+func (c *Client) GetChangelist(ctx context.Context, id string) (Changelist, error) {
+	return api.GetIsiChangelist(ctx, c.API, id)
+}
+
+// RemoveChangelist deletes a changelist by ID.
+func (c *Client) RemoveChangelist(ctx context.Context, id string) error {
+	return api.RemoveIsiChangelist(ctx, c.API, id)
+}
+
+// ChangelistEntries returns an iterator over the entries of a changelist.
+// Entries are requested from the cluster one page at a time as the iterator
+// advances. Iteration stops after the first error, which is yielded with a
+// nil entry.
+func (c *Client) ChangelistEntries(ctx context.Context, id string) iter.Seq2[ChangelistEntry, error] {
+	return func(yield func(ChangelistEntry, error) bool) {
+		resume := ""
+		for {
+			page, err := api.GetIsiChangelistEntries(ctx, c.API, id, changelistPageSize, resume)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, entry := range page.Entries {
+				if !yield(entry, nil) {
+					return
+				}
+			}
+			if page.Resume == "" {
+				return
+			}
+			resume = page.Resume
+		}
+	}
+}
@@ -0,0 +1,116 @@
+/*
+Copyright (c) 2025 Dell Inc, or its subsidiaries.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package goisilon
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	apiv1 "github.com/dell/goisilon/api/v1"
+	"github.com/dell/goisilon/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func mockSnapshot(c *mocks.Client, snapshot *apiv1.IsiSnapshot) *mock.Call {
+	return c.On("Get", mock.Anything, fmt.Sprintf("platform/1/snapshot/snapshots/%d", snapshot.ID), "", mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(**apiv1.GetIsiSnapshotsResp)
+		*resp = &apiv1.GetIsiSnapshotsResp{SnapshotList: []*apiv1.IsiSnapshot{snapshot}}
+	})
+}
+
+func TestCreateChangelist(t *testing.T) {
+	client := &Client{API: new(mocks.Client)}
+	ctx := context.Background()
+
+	mockSnapshot(client.API.(*mocks.Client), &apiv1.IsiSnapshot{ID: 2, Path: "/ifs/data/vol", Created: 100})
+	mockSnapshot(client.API.(*mocks.Client), &apiv1.IsiSnapshot{ID: 5, Path: "/ifs/data/vol", Created: 200})
+	mockSnapshot(client.API.(*mocks.Client), &apiv1.IsiSnapshot{ID: 6, Path: "/ifs/data/other", Created: 300})
+
+	_, err := client.CreateChangelist(ctx, 2, 6, nil)
+	assert.EqualError(t, err, "snapshots 2 (/ifs/data/vol) and 6 (/ifs/data/other) are not of the same path")
+	_, err = client.CreateChangelist(ctx, 5, 2, nil)
+	assert.EqualError(t, err, "snapshot 5 is newer than snapshot 2")
+
+	client.API.(*mocks.Client).On("Post", ctx, "platform/1/job/jobs", "", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).Run(func(args mock.Arguments) {
+		req := args.Get(5).(*apiv1.IsiJobReq)
+		assert.Equal(t, JobTypeChangelistCreate, req.Type)
+		assert.Equal(t, int64(2), req.ChangelistcreateParams.OlderSnapid)
+		assert.Equal(t, int64(5), req.ChangelistcreateParams.NewerSnapid)
+	}).Once()
+	mockJobState(client.API.(*mocks.Client), "0", JobEngineStateSucceeded)
+	mockJobState(client.API.(*mocks.Client), "42", JobEngineStateSucceeded)
+	client.API.(*mocks.Client).On("Get", ctx, "platform/1/snapshot/changelists", "2_5", mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*apiv1.IsiChangelistsResp)
+		resp.Changelists = []*apiv1.IsiChangelist{{ID: "2_5", Snap1: 2, Snap2: 5}}
+	}).Once()
+
+	changelist, err := client.CreateChangelist(ctx, 2, 5, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "2_5", changelist.ID)
+}
+
+func TestChangelistEntries(t *testing.T) {
+	client := &Client{API: new(mocks.Client)}
+	ctx := context.Background()
+
+	client.API.(*mocks.Client).On("Get", ctx, "platform/1/snapshot/changelists/2_5/lins", "", mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*apiv1.IsiChangelistEntriesResp)
+		resp.Entries = []*apiv1.IsiChangelistEntry{
+			{Path: "/ifs/data/vol/a", ChangeTypes: ChangelistEntryAdded},
+			{Path: "/ifs/data/vol/b", ChangeTypes: ChangelistEntryModified},
+		}
+		resp.Resume = "next"
+	}).Once()
+	client.API.(*mocks.Client).On("Get", ctx, "platform/1/snapshot/changelists/2_5/lins", "", mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*apiv1.IsiChangelistEntriesResp)
+		resp.Entries = []*apiv1.IsiChangelistEntry{
+			{Path: "/ifs/data/vol/c", ChangeTypes: ChangelistEntryRemoved},
+		}
+	}).Once()
+
+	var paths []string
+	for entry, err := range client.ChangelistEntries(ctx, "2_5") {
+		assert.Nil(t, err)
+		paths = append(paths, entry.Path)
+	}
+	assert.Equal(t, []string{"/ifs/data/vol/a", "/ifs/data/vol/b", "/ifs/data/vol/c"}, paths)
+
+	// stopping early does not request further pages
+	client.API.(*mocks.Client).On("Get", ctx, "platform/1/snapshot/changelists/2_5/lins", "", mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*apiv1.IsiChangelistEntriesResp)
+		resp.Entries = []*apiv1.IsiChangelistEntry{{Path: "/ifs/data/vol/a"}, {Path: "/ifs/data/vol/b"}}
+		resp.Resume = "next"
+	}).Once()
+	for range client.ChangelistEntries(ctx, "2_5") {
+		break
+	}
+
+	client.API.(*mocks.Client).On("Get", ctx, "platform/1/snapshot/changelists/2_5/lins", "", mock.Anything, mock.Anything, mock.Anything).
+		Return(fmt.Errorf("changelist not found")).Once()
+	for entry, err := range client.ChangelistEntries(ctx, "2_5") {
+		assert.Nil(t, entry)
+		assert.EqualError(t, err, "changelist not found")
+	}
+	client.API.(*mocks.Client).AssertExpectations(t)
+}
@@ -0,0 +1,327 @@
+/*
+Copyright (c) 2025 Dell Inc, or its subsidiaries.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package goisilon
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/akutz/gournal"
+	apiv1 "github.com/dell/goisilon/api/v1"
+	apiv2 "github.com/dell/goisilon/api/v2"
+)
+
+const (
+	defaultDeleteRetries       = 3
+	defaultDeleteRetryInterval = time.Second
+)
+
+// Phases of a DeleteTree reported by DeleteProgress.
+const (
+	DeletePhaseList   = "list"
+	DeletePhaseFix    = "fix"
+	DeletePhaseDelete = "delete"
+	DeletePhaseJob    = "job"
+)
+
+// DeleteProgress describes the progress of a DeleteTree.
+type DeleteProgress struct {
+	Phase string
+	// Listed is the number of entries found below the root.
+	Listed int64
+	// Fixed is the number of directories whose ownership was reset.
+	Fixed int64
+	// Deleted is the number of entries deleted below the root.
+	Deleted int64
+	// Job is the TreeDelete job in the job phase.
+	Job Job
+}
+
+// DeleteOptions configures DeleteTree.
+type DeleteOptions struct {
+	// Workers is the number of requests sent concurrently, it defaults to
+	// ConcurrentHTTPConnections.
+	Workers int
+	// MaxRetries is the number of times a failed request for an entry is
+	// retried, it defaults to 3. A negative value disables retries.
+	MaxRetries int
+	// RetryInterval is the delay before a retry, it defaults to 1 second.
+	RetryInterval time.Duration
+	// FixOwnership resets the owner of the directories not owned by the API
+	// user to that user, with mode 0755, so that their entries can be deleted.
+	FixOwnership bool
+	// Recursive deletes the tree with a single recursive request, after the
+	// ownership is reset, instead of deleting its entries one by one. The
+	// listed paths are then not kept.
+	Recursive bool
+	// TreeDeleteThreshold is the number of entries above which the tree is
+	// deleted by a TreeDelete job engine job. 0 never uses the job.
+	TreeDeleteThreshold int64
+	// OnProgress is called after every change of the progress, never concurrently.
+	OnProgress func(DeleteProgress)
+}
+
+type treeDeleter struct {
+	c    *Client
+	opts DeleteOptions
+
+	mu       sync.Mutex
+	progress DeleteProgress
+}
+
+func (d *treeDeleter) update(fn func(p *DeleteProgress)) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	fn(&d.progress)
+	if d.opts.OnProgress != nil {
+		d.opts.OnProgress(d.progress)
+	}
+}
+
+// retry calls fn until it succeeds or the retries are exhausted.
+func (d *treeDeleter) retry(ctx context.Context, fn func() error) error {
+	var err error
+	for attempt := 0; attempt <= d.opts.MaxRetries; attempt++ {
+		if attempt > 0 {
+			if sleepErr := sleepWithContext(ctx, d.opts.RetryInterval); sleepErr != nil {
+				return err
+			}
+		}
+		if err = fn(); err == nil || ctx.Err() != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// forEach calls fn for every path with at most Workers calls at once, and
+// returns the first error, after which no new calls are made.
+func (d *treeDeleter) forEach(ctx context.Context, paths []string, fn func(ctx context.Context, p string) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		sem      = make(chan struct{}, d.opts.Workers)
+		errOnce  sync.Once
+		firstErr error
+	)
+	for _, p := range paths {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			if err := fn(ctx, p); err != nil {
+				errOnce.Do(func() {
+					firstErr = err
+					cancel()
+				})
+			}
+		}()
+	}
+	wg.Wait()
+	if firstErr == nil {
+		// the parent context was canceled
+		return ctx.Err()
+	}
+	return firstErr
+}
+
+// deleteEntry deletes the file or empty directory at p, which may already be gone.
+func (d *treeDeleter) deleteEntry(ctx context.Context, p string, recursive bool) error {
+	err := d.retry(ctx, func() error {
+		err := apiv1.DeleteIsiNamespaceEntry(ctx, d.c.API, p, recursive)
+		if isNotFoundError(err) {
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete %s: %w", p, err)
+	}
+	return nil
+}
+
+// DeleteTree deletes the file or directory tree at the absolute path isiPath.
+// The tree is listed and its entries are deleted from the deepest up, or all
+// at once if opts.Recursive is set. Failed requests are retried and the first
+// error that remains stops the delete.
+// Trees larger than opts.TreeDeleteThreshold are deleted by a TreeDelete job
+// instead, which is canceled if ctx is.
+func (c *Client) DeleteTree(ctx context.Context, isiPath string, opts *DeleteOptions) error {
+	d := &treeDeleter{c: c}
+	if opts != nil {
+		d.opts = *opts
+	}
+	if d.opts.Workers <= 0 {
+		d.opts.Workers = ConcurrentHTTPConnections
+	}
+	if d.opts.MaxRetries == 0 {
+		d.opts.MaxRetries = defaultDeleteRetries
+	} else if d.opts.MaxRetries < 0 {
+		d.opts.MaxRetries = 0
+	}
+	if d.opts.RetryInterval <= 0 {
+		d.opts.RetryInterval = defaultDeleteRetryInterval
+	}
+	isiPath = path.Clean(isiPath)
+
+	root, err := apiv1.GetIsiNamespaceEntry(ctx, c.API, isiPath)
+	if isNotFoundError(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if root.Type != apiv1.IsiNamespaceEntryTypeContainer {
+		return d.deleteEntry(ctx, isiPath, false)
+	}
+
+	if d.opts.TreeDeleteThreshold > 0 {
+		summary, err := c.SummarizeTree(ctx, isiPath, &TreeSummaryOptions{
+			Workers:    d.opts.Workers,
+			MaxEntries: d.opts.TreeDeleteThreshold + 1,
+		})
+		if err != nil {
+			return err
+		}
+		if summary.Inodes > d.opts.TreeDeleteThreshold {
+			return d.treeDeleteJob(ctx, isiPath)
+		}
+	}
+
+	if d.opts.Recursive && !d.opts.FixOwnership {
+		return d.deleteEntry(ctx, isiPath, true)
+	}
+
+	var (
+		user   = c.API.User()
+		levels [][]string
+		fix    []string
+		mode   = apiv2.FileMode(0o755)
+		acl    = &apiv2.ACL{
+			Action:        &apiv2.PActionTypeReplace,
+			Authoritative: &apiv2.PAuthoritativeTypeMode,
+			Owner: &apiv2.Persona{
+				ID: &apiv2.PersonaID{
+					ID:   user,
+					Type: apiv2.PersonaIDTypeUser,
+				},
+			},
+			Mode: &mode,
+		}
+	)
+	err = c.WalkWithOptions(ctx, isiPath, &WalkOptions{Workers: d.opts.Workers, Detail: []string{"owner"}},
+		func(p string, e *DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if p == isiPath {
+				return nil
+			}
+			d.update(func(pr *DeleteProgress) {
+				pr.Phase = DeletePhaseList
+				pr.Listed++
+			})
+			if d.opts.FixOwnership && e.IsDir() && !strings.EqualFold(user, e.Owner) {
+				fix = append(fix, p)
+			}
+			if d.opts.Recursive {
+				return nil
+			}
+			depth := strings.Count(strings.TrimPrefix(p, isiPath), "/")
+			for len(levels) < depth {
+				levels = append(levels, nil)
+			}
+			levels[depth-1] = append(levels[depth-1], p)
+			return nil
+		})
+	if err != nil {
+		return err
+	}
+
+	// the ownership is reset once the walk is done so that the requests are
+	// not serialized by the walk
+	err = d.forEach(ctx, fix, func(ctx context.Context, p string) error {
+		if err := d.retry(ctx, func() error { return apiv2.ACLUpdateWithIsiPath(ctx, c.API, p, acl) }); err != nil {
+			return fmt.Errorf("failed to reset the ownership of %s: %w", p, err)
+		}
+		d.update(func(pr *DeleteProgress) {
+			pr.Phase = DeletePhaseFix
+			pr.Fixed++
+		})
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for i := len(levels) - 1; i >= 0; i-- {
+		err = d.forEach(ctx, levels[i], func(ctx context.Context, p string) error {
+			if err := d.deleteEntry(ctx, p, false); err != nil {
+				return err
+			}
+			d.update(func(pr *DeleteProgress) {
+				pr.Phase = DeletePhaseDelete
+				pr.Deleted++
+			})
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return d.deleteEntry(ctx, isiPath, true)
+}
+
+// treeDeleteJob deletes the tree at isiPath with a TreeDelete job and waits for it.
+func (d *treeDeleter) treeDeleteJob(ctx context.Context, isiPath string) error {
+	job, err := d.c.StartJob(ctx, &apiv1.IsiJobReq{
+		Type:     JobTypeTreeDelete,
+		AllowDup: true,
+		Paths:    []string{isiPath},
+	})
+	if err != nil {
+		return err
+	}
+	d.update(func(p *DeleteProgress) {
+		p.Phase = DeletePhaseJob
+		p.Job = job
+	})
+	_, err = d.c.WaitForJob(ctx, job.ID, func(j Job) {
+		d.update(func(p *DeleteProgress) { p.Job = j })
+	})
+	if err != nil {
+		if ctx.Err() != nil {
+			if cancelErr := d.c.CancelJob(context.WithoutCancel(ctx), job.ID); cancelErr != nil {
+				log.Warn(ctx, "unable to cancel %s job %d: %v", JobTypeTreeDelete, job.ID, cancelErr)
+			}
+		}
+		return err
+	}
+	return d.deleteEntry(ctx, isiPath, true)
+}
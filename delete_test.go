@@ -0,0 +1,169 @@
+/*
+Copyright (c) 2025 Dell Inc, or its subsidiaries.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package goisilon
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dell/goisilon/api"
+	apiv1 "github.com/dell/goisilon/api/v1"
+	"github.com/dell/goisilon/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// mockNamespaceDelete records the deleted namespace entries, failing[id]
+// returns errors for the first deletes of id.
+func mockNamespaceDelete(c *Client, failing map[string][]error) *[]string {
+	var (
+		mu      sync.Mutex
+		deleted []string
+	)
+	c.API.(*mocks.Client).On("Delete", mock.Anything, "namespace", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, _, id string, _ api.OrderedValues, _ map[string]string, _ interface{}) error {
+			mu.Lock()
+			defer mu.Unlock()
+			if errs := failing[id]; len(errs) > 0 {
+				failing[id] = errs[1:]
+				return errs[0]
+			}
+			deleted = append(deleted, id)
+			return nil
+		})
+	return &deleted
+}
+
+func TestDeleteTree(t *testing.T) {
+	ctx := context.Background()
+	client := &Client{API: new(mocks.Client)}
+	client.API.(*mocks.Client).On("User").Return("admin")
+	mockNamespaceFiles(client, testTree)
+	mockNamespaceTree(client, testTree, nil)
+	deleted := mockNamespaceDelete(client, map[string][]error{"ifs/data/a/2": {errors.New("busy")}})
+
+	var fixed []string
+	client.API.(*mocks.Client).On("Put", mock.Anything, "namespace", mock.Anything, mock.Anything, mock.Anything, mock.Anything, nil).Return(nil).Run(func(args mock.Arguments) {
+		fixed = append(fixed, args.String(2))
+	})
+
+	var last DeleteProgress
+	err := client.DeleteTree(ctx, "/ifs/data", &DeleteOptions{
+		FixOwnership:  true,
+		RetryInterval: time.Millisecond,
+		OnProgress:    func(p DeleteProgress) { last = p },
+	})
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"ifs/data/a", "ifs/data/b", "ifs/data/b/deep"}, fixed)
+	assert.Equal(t, DeleteProgress{Phase: DeletePhaseDelete, Listed: 9 - 1, Fixed: 3, Deleted: 8}, last)
+
+	// entries are deleted from the deepest up and the root last
+	depth := map[string]int{}
+	for i, id := range *deleted {
+		depth[id] = i
+	}
+	assert.Len(t, *deleted, 9)
+	assert.Less(t, depth["ifs/data/b/deep/x"], depth["ifs/data/b/deep"])
+	assert.Less(t, depth["ifs/data/b/deep"], depth["ifs/data/b"])
+	assert.Less(t, depth["ifs/data/a/2"], depth["ifs/data/a"])
+	assert.Equal(t, "ifs/data", (*deleted)[8])
+}
+
+func TestDeleteTreeErrors(t *testing.T) {
+	ctx := context.Background()
+	client := &Client{API: new(mocks.Client)}
+	client.API.(*mocks.Client).On("User").Return("admin")
+	mockNamespaceFiles(client, testTree)
+	mockNamespaceTree(client, testTree, nil)
+	busy := errors.New("busy")
+	deleted := mockNamespaceDelete(client, map[string][]error{"ifs/data/b/deep/x": {busy, busy}})
+
+	err := client.DeleteTree(ctx, "/ifs/data", &DeleteOptions{MaxRetries: 1, RetryInterval: time.Millisecond})
+	assert.EqualError(t, err, "failed to delete /ifs/data/b/deep/x: busy")
+	assert.ErrorIs(t, err, busy)
+	assert.NotContains(t, *deleted, "ifs/data/b/deep")
+
+	// files are deleted directly and missing entries are already deleted
+	assert.NoError(t, client.DeleteTree(ctx, "/ifs/data/c.txt", nil))
+	assert.Contains(t, *deleted, "ifs/data/c.txt")
+	assert.NoError(t, client.DeleteTree(ctx, "/ifs/missing", nil))
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	assert.ErrorIs(t, client.DeleteTree(canceled, "/ifs/data", nil), context.Canceled)
+}
+
+func TestDeleteTreeRecursive(t *testing.T) {
+	ctx := context.Background()
+	client := &Client{API: new(mocks.Client)}
+	client.API.(*mocks.Client).On("User").Return("admin")
+	mockNamespaceFiles(client, testTree)
+	mockNamespaceTree(client, testTree, nil)
+
+	var calls []string
+	client.API.(*mocks.Client).On("Put", mock.Anything, "namespace", mock.Anything, mock.Anything, mock.Anything, mock.Anything, nil).Return(nil).Run(func(args mock.Arguments) {
+		calls = append(calls, "fix "+args.String(2))
+	})
+	client.API.(*mocks.Client).On("Delete", mock.Anything, "namespace", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		params := args.Get(3).(api.OrderedValues)
+		calls = append(calls, "delete "+args.String(2)+"?"+params.String())
+	})
+
+	err := client.DeleteTree(ctx, "/ifs/data", &DeleteOptions{FixOwnership: true, Recursive: true, Workers: 1})
+	assert.NoError(t, err)
+	assert.Len(t, calls, 4)
+	assert.ElementsMatch(t, []string{"fix ifs/data/a", "fix ifs/data/b", "fix ifs/data/b/deep"}, calls[:3])
+	assert.Equal(t, "delete ifs/data?recursive=true", calls[3])
+
+	// without fixing the ownership the tree is not listed
+	calls = nil
+	assert.NoError(t, client.DeleteTree(ctx, "/ifs/data", &DeleteOptions{Recursive: true}))
+	assert.Equal(t, []string{"delete ifs/data?recursive=true"}, calls)
+}
+
+func TestDeleteTreeJob(t *testing.T) {
+	ctx := context.Background()
+	client := &Client{API: new(mocks.Client)}
+	mockNamespaceFiles(client, testTree)
+	mockQuotaUsage(client, "/ifs/data", &apiv1.IsiQuota{Path: "/ifs/data", Type: "directory", Ready: true, Usage: struct {
+		Inodes   int64 `json:"inodes"`
+		Logical  int64 `json:"logical"`
+		Physical int64 `json:"physical"`
+	}{Inodes: 1000}})
+	deleted := mockNamespaceDelete(client, nil)
+
+	client.API.(*mocks.Client).On("Post", ctx, "platform/1/job/jobs", "", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).Run(func(args mock.Arguments) {
+		req := args.Get(5).(*apiv1.IsiJobReq)
+		assert.Equal(t, JobTypeTreeDelete, req.Type)
+		assert.Equal(t, []string{"/ifs/data"}, req.Paths)
+	}).Once()
+	mockJobState(client.API.(*mocks.Client), "0", JobEngineStateRunning).Once()
+	mockJobState(client.API.(*mocks.Client), "42", JobEngineStateSucceeded).Once()
+
+	var phases []string
+	err := client.DeleteTree(ctx, "/ifs/data", &DeleteOptions{
+		TreeDeleteThreshold: 100,
+		OnProgress:          func(p DeleteProgress) { phases = append(phases, p.Phase) },
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ifs/data"}, *deleted)
+	assert.Equal(t, []string{DeletePhaseJob, DeletePhaseJob}, phases)
+}
@@ -0,0 +1,449 @@
+/*
+Copyright (c) 2025 Dell Inc, or its subsidiaries.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package goisilon
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	log "github.com/akutz/gournal"
+)
+
+// FailoverOperation is an operation run by a FailoverWorkflow.
+type FailoverOperation string
+
+const (
+	// PlannedFailover syncs the policy one last time, disables it on the
+	// source cluster and allows writes on the target cluster.
+	PlannedFailover FailoverOperation = "planned_failover"
+	// UnplannedFailover allows writes on the target cluster without
+	// contacting the source cluster, which is assumed to be unavailable.
+	UnplannedFailover FailoverOperation = "unplanned_failover"
+	// Failback returns a failed over policy to the source cluster, copying
+	// the changes made on the target cluster back first.
+	Failback FailoverOperation = "failback"
+)
+
+// Names of the steps run by a FailoverWorkflow.
+const (
+	FailoverStepFinalSync           = "final-sync"
+	FailoverStepDisableSourcePolicy = "disable-source-policy"
+	FailoverStepAllowWritesOnTarget = "allow-writes-on-target"
+	FailoverStepResyncPrepSource    = "resync-prep-source"
+	FailoverStepSyncMirror          = "sync-mirror"
+	FailoverStepDisableMirrorPolicy = "disable-mirror-policy"
+	FailoverStepAllowWritesOnSource = "allow-writes-on-source"
+	FailoverStepResyncPrepMirror    = "resync-prep-mirror"
+	FailoverStepEnableSourcePolicy  = "enable-source-policy"
+)
+
+const (
+	failoverMirrorPolicySuffix       = "_mirror"
+	failoverStateFilePermissions     = 0o600
+	failoverStateDirectoryPermission = 0o700
+)
+
+// FailoverStep is a single step of a FailoverWorkflow operation.
+type FailoverStep struct {
+	Name string
+	// Cluster is "source" or "target", the cluster the step acts on.
+	Cluster     string
+	Description string
+	// Done is true if a previous, interrupted run already completed the step.
+	Done bool
+
+	run func(ctx context.Context) error
+}
+
+// FailoverState records the progress of a FailoverWorkflow operation so that
+// an interrupted run can be resumed.
+type FailoverState struct {
+	Operation      FailoverOperation `json:"operation"`
+	PolicyName     string            `json:"policy_name"`
+	CompletedSteps []string          `json:"completed_steps"`
+	StartedAt      time.Time         `json:"started_at"`
+	UpdatedAt      time.Time         `json:"updated_at"`
+}
+
+// FailoverStateStore persists FailoverState between runs.
+type FailoverStateStore interface {
+	// Load returns the state saved for the policy, or nil if there is none.
+	Load(ctx context.Context, policyName string) (*FailoverState, error)
+	Save(ctx context.Context, state *FailoverState) error
+	Delete(ctx context.Context, policyName string) error
+}
+
+// FileFailoverStateStore is a FailoverStateStore keeping one JSON file per policy in Dir.
+type FileFailoverStateStore struct {
+	Dir string
+}
+
+func (s *FileFailoverStateStore) path(policyName string) string {
+	return filepath.Join(s.Dir, filepath.Base(policyName)+".json")
+}
+
+// Load reads the state saved for the policy, or nil if there is none.
+func (s *FileFailoverStateStore) Load(_ context.Context, policyName string) (*FailoverState, error) {
+	data, err := os.ReadFile(s.path(policyName))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	state := &FailoverState{}
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("failed to parse failover state of policy %s: %v", policyName, err)
+	}
+	return state, nil
+}
+
+// Save writes the state, replacing the file atomically so that a crash never leaves a partial file.
+func (s *FileFailoverStateStore) Save(_ context.Context, state *FailoverState) error {
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(s.Dir, failoverStateDirectoryPermission); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(s.Dir, filepath.Base(state.PolicyName)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(failoverStateFilePermissions); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.path(state.PolicyName))
+}
+
+// Delete removes the state saved for the policy.
+func (s *FileFailoverStateStore) Delete(_ context.Context, policyName string) error {
+	err := os.Remove(s.path(policyName))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// FailoverWorkflow sequences the SyncIQ calls needed to fail a policy over
+// from its source cluster to its target cluster and back.
+type FailoverWorkflow struct {
+	Source     *Client
+	Target     *Client
+	PolicyName string
+	// MirrorPolicyName is the policy resync-prep creates on the target
+	// cluster, it defaults to PolicyName with a "_mirror" suffix.
+	MirrorPolicyName string
+
+	// Store persists step state so an interrupted run can resume where it
+	// stopped. Without a store every run starts from the first step.
+	Store FailoverStateStore
+	// StepTimeout bounds the run time of each step and replaces the timeouts
+	// of the waiters the step runs. 0 keeps the default waiter timeouts.
+	StepTimeout time.Duration
+	// StepTimeouts overrides StepTimeout for the named steps.
+	StepTimeouts map[string]time.Duration
+	// StartDelay is the time given to a job started by a step to show up
+	// before it is waited for, it defaults to 3 seconds.
+	StartDelay time.Duration
+}
+
+// NewFailoverWorkflow returns a workflow for the policy replicating from source to target.
+func NewFailoverWorkflow(source, target *Client, policyName string) *FailoverWorkflow {
+	return &FailoverWorkflow{
+		Source:     source,
+		Target:     target,
+		PolicyName: policyName,
+	}
+}
+
+func (w *FailoverWorkflow) mirrorPolicyName() string {
+	if w.MirrorPolicyName != "" {
+		return w.MirrorPolicyName
+	}
+	return w.PolicyName + failoverMirrorPolicySuffix
+}
+
+// stepWaitOptions returns the default wait options with the timeout extended
+// or shortened to the deadline of the step, if it has one.
+func stepWaitOptions(ctx context.Context) WaitOptions {
+	opts := DefaultWaitOptions()
+	if deadline, ok := ctx.Deadline(); ok {
+		opts.Timeout = time.Until(deadline)
+	}
+	return opts
+}
+
+// stepSyncOptions returns the default sync options waiting as stepWaitOptions.
+func (w *FailoverWorkflow) stepSyncOptions(ctx context.Context) SyncOptions {
+	opts := DefaultSyncOptions()
+	opts.Wait = stepWaitOptions(ctx)
+	if w.StartDelay > 0 {
+		opts.StartDelay = w.StartDelay
+	}
+	return opts
+}
+
+// resyncPrep runs resync-prep of the policy on c and waits for the job to
+// finish. It fails unless the job finished successfully.
+func (w *FailoverWorkflow) resyncPrep(ctx context.Context, c *Client, policyName string) error {
+	if err := c.ResyncPrep(ctx, policyName); err != nil {
+		return err
+	}
+	opts := w.stepSyncOptions(ctx)
+	if err := sleepWithContext(ctx, opts.StartDelay); err != nil {
+		return err
+	}
+	if err := c.WaitForNoActiveJobsWithOptions(ctx, policyName, opts.Wait); err != nil {
+		return err
+	}
+	err := c.WaitForPolicyLastJobStateWithOptions(ctx, policyName, opts.Wait, FINISHED, FAILED, CANCELED, NeedsAttention)
+	if err != nil {
+		return err
+	}
+	policy, err := c.GetPolicyByName(ctx, policyName)
+	if err != nil {
+		return err
+	}
+	if policy.LastJobState != FINISHED {
+		return fmt.Errorf("resync-prep of policy %s ended in state %s", policyName, policy.LastJobState)
+	}
+	return nil
+}
+
+func (w *FailoverWorkflow) steps(op FailoverOperation) ([]FailoverStep, error) {
+	policy := w.PolicyName
+	mirror := w.mirrorPolicyName()
+	switch op {
+	case PlannedFailover:
+		return []FailoverStep{
+			{
+				Name: FailoverStepFinalSync, Cluster: "source",
+				Description: fmt.Sprintf("run a final sync of policy %s", policy),
+				run: func(ctx context.Context) error {
+					return w.Source.SyncPolicyWithOptions(ctx, policy, w.stepSyncOptions(ctx))
+				},
+			},
+			{
+				Name: FailoverStepDisableSourcePolicy, Cluster: "source",
+				Description: fmt.Sprintf("disable policy %s", policy),
+				run: func(ctx context.Context) error {
+					if err := w.Source.DisablePolicy(ctx, policy); err != nil {
+						return err
+					}
+					return w.Source.WaitForPolicyEnabledFieldConditionWithOptions(ctx, policy, false, stepWaitOptions(ctx))
+				},
+			},
+			{
+				Name: FailoverStepAllowWritesOnTarget, Cluster: "target",
+				Description: fmt.Sprintf("allow writes to the target directory of policy %s", policy),
+				run: func(ctx context.Context) error {
+					return w.Target.AllowWritesWithOptions(ctx, policy, stepWaitOptions(ctx))
+				},
+			},
+		}, nil
+	case UnplannedFailover:
+		return []FailoverStep{
+			{
+				Name: FailoverStepAllowWritesOnTarget, Cluster: "target",
+				Description: fmt.Sprintf("allow writes to the target directory of policy %s", policy),
+				run: func(ctx context.Context) error {
+					return w.Target.AllowWritesWithOptions(ctx, policy, stepWaitOptions(ctx))
+				},
+			},
+		}, nil
+	case Failback:
+		return []FailoverStep{
+			{
+				Name: FailoverStepResyncPrepSource, Cluster: "source",
+				Description: fmt.Sprintf("run resync-prep of policy %s to create mirror policy %s", policy, mirror),
+				run: func(ctx context.Context) error {
+					return w.resyncPrep(ctx, w.Source, policy)
+				},
+			},
+			{
+				Name: FailoverStepSyncMirror, Cluster: "target",
+				Description: fmt.Sprintf("enable and sync mirror policy %s", mirror),
+				run: func(ctx context.Context) error {
+					if err := w.Target.EnablePolicy(ctx, mirror); err != nil {
+						return err
+					}
+					if err := w.Target.WaitForPolicyEnabledFieldConditionWithOptions(ctx, mirror, true, stepWaitOptions(ctx)); err != nil {
+						return err
+					}
+					return w.Target.SyncPolicyWithOptions(ctx, mirror, w.stepSyncOptions(ctx))
+				},
+			},
+			{
+				Name: FailoverStepDisableMirrorPolicy, Cluster: "target",
+				Description: fmt.Sprintf("disable mirror policy %s", mirror),
+				run: func(ctx context.Context) error {
+					if err := w.Target.DisablePolicy(ctx, mirror); err != nil {
+						return err
+					}
+					return w.Target.WaitForPolicyEnabledFieldConditionWithOptions(ctx, mirror, false, stepWaitOptions(ctx))
+				},
+			},
+			{
+				Name: FailoverStepAllowWritesOnSource, Cluster: "source",
+				Description: fmt.Sprintf("allow writes to the target directory of mirror policy %s", mirror),
+				run: func(ctx context.Context) error {
+					return w.Source.AllowWritesWithOptions(ctx, mirror, stepWaitOptions(ctx))
+				},
+			},
+			{
+				Name: FailoverStepResyncPrepMirror, Cluster: "target",
+				Description: fmt.Sprintf("run resync-prep of mirror policy %s to restore policy %s", mirror, policy),
+				run: func(ctx context.Context) error {
+					return w.resyncPrep(ctx, w.Target, mirror)
+				},
+			},
+			{
+				Name: FailoverStepEnableSourcePolicy, Cluster: "source",
+				Description: fmt.Sprintf("enable policy %s", policy),
+				run: func(ctx context.Context) error {
+					if err := w.Source.EnablePolicy(ctx, policy); err != nil {
+						return err
+					}
+					return w.Source.WaitForPolicyEnabledFieldConditionWithOptions(ctx, policy, true, stepWaitOptions(ctx))
+				},
+			},
+		}, nil
+	}
+	return nil, fmt.Errorf("unknown failover operation %s", op)
+}
+
+// loadState returns the saved state of an unfinished run of op, or a new state.
+func (w *FailoverWorkflow) loadState(ctx context.Context, op FailoverOperation) (*FailoverState, error) {
+	if w.Store != nil {
+		state, err := w.Store.Load(ctx, w.PolicyName)
+		if err != nil {
+			return nil, err
+		}
+		if state != nil {
+			if state.Operation != op {
+				return nil, fmt.Errorf("an unfinished %s of policy %s must be completed first", state.Operation, w.PolicyName)
+			}
+			return state, nil
+		}
+	}
+	now := time.Now()
+	return &FailoverState{Operation: op, PolicyName: w.PolicyName, StartedAt: now, UpdatedAt: now}, nil
+}
+
+// plan returns the steps of op along with the state of the run, marking the
+// steps an interrupted run already completed as Done.
+func (w *FailoverWorkflow) plan(ctx context.Context, op FailoverOperation) ([]FailoverStep, *FailoverState, error) {
+	steps, err := w.steps(op)
+	if err != nil {
+		return nil, nil, err
+	}
+	state, err := w.loadState(ctx, op)
+	if err != nil {
+		return nil, nil, err
+	}
+	for i := range steps {
+		steps[i].Done = slices.Contains(state.CompletedSteps, steps[i].Name)
+	}
+	return steps, state, nil
+}
+
+// Plan returns the steps op would run without running them. Steps already
+// completed by an interrupted run are marked Done and would be skipped.
+func (w *FailoverWorkflow) Plan(ctx context.Context, op FailoverOperation) ([]FailoverStep, error) {
+	steps, _, err := w.plan(ctx, op)
+	return steps, err
+}
+
+func (w *FailoverWorkflow) stepTimeout(name string) time.Duration {
+	if timeout, ok := w.StepTimeouts[name]; ok {
+		return timeout
+	}
+	return w.StepTimeout
+}
+
+func (w *FailoverWorkflow) runStep(ctx context.Context, step FailoverStep) error {
+	if timeout := w.stepTimeout(step.Name); timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	return step.run(ctx)
+}
+
+// Run runs op, resuming an interrupted run of the same operation if the store holds one.
+func (w *FailoverWorkflow) Run(ctx context.Context, op FailoverOperation) error {
+	steps, state, err := w.plan(ctx, op)
+	if err != nil {
+		return err
+	}
+
+	for _, step := range steps {
+		if step.Done {
+			log.Info(ctx, "%s of policy %s: step %s already completed, skipping it", op, w.PolicyName, step.Name)
+			continue
+		}
+		log.Info(ctx, "%s of policy %s: %s on %s cluster", op, w.PolicyName, step.Description, step.Cluster)
+
+		if err := w.runStep(ctx, step); err != nil {
+			return fmt.Errorf("%s of policy %s failed at step %s: %w", op, w.PolicyName, step.Name, err)
+		}
+
+		state.CompletedSteps = append(state.CompletedSteps, step.Name)
+		state.UpdatedAt = time.Now()
+		if w.Store != nil {
+			if err := w.Store.Save(ctx, state); err != nil {
+				return fmt.Errorf("failed to save %s state of policy %s: %w", op, w.PolicyName, err)
+			}
+		}
+	}
+
+	if w.Store != nil {
+		return w.Store.Delete(ctx, w.PolicyName)
+	}
+	return nil
+}
+
+// PlannedFailover runs the PlannedFailover operation.
+func (w *FailoverWorkflow) PlannedFailover(ctx context.Context) error {
+	return w.Run(ctx, PlannedFailover)
+}
+
+// UnplannedFailover runs the UnplannedFailover operation.
+func (w *FailoverWorkflow) UnplannedFailover(ctx context.Context) error {
+	return w.Run(ctx, UnplannedFailover)
+}
+
+// Failback runs the Failback operation.
+func (w *FailoverWorkflow) Failback(ctx context.Context) error {
+	return w.Run(ctx, Failback)
+}
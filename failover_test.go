@@ -0,0 +1,197 @@
+/*
+Copyright (c) 2025 Dell Inc, or its subsidiaries.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package goisilon
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dell/goisilon/api/common/utils/poll"
+	apiv11 "github.com/dell/goisilon/api/v11"
+	"github.com/dell/goisilon/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func mockPolicy(c *mocks.Client, policy apiv11.Policy) *mock.Call {
+	return c.On("Get", mock.Anything, "/platform/11/sync/policies/", policy.Name, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(**apiv11.Policies)
+		*resp = &apiv11.Policies{Policy: []apiv11.Policy{policy}}
+	})
+}
+
+func mockTargetPolicy(c *mocks.Client, policy apiv11.TargetPolicy) *mock.Call {
+	return c.On("Get", mock.Anything, "/platform/11/sync/target/policies/", policy.Name, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(**apiv11.TargetPolicies)
+		*resp = &apiv11.TargetPolicies{Policy: []apiv11.TargetPolicy{policy}}
+	})
+}
+
+func TestFailoverWorkflowPlan(t *testing.T) {
+	ctx := context.Background()
+	source := &Client{API: new(mocks.Client)}
+	target := &Client{API: new(mocks.Client)}
+	store := &FileFailoverStateStore{Dir: t.TempDir()}
+	w := NewFailoverWorkflow(source, target, "policy")
+	w.Store = store
+
+	steps, err := w.Plan(ctx, Failback)
+	assert.NoError(t, err)
+	var names []string
+	for _, step := range steps {
+		names = append(names, step.Name)
+		assert.False(t, step.Done)
+	}
+	assert.Equal(t, []string{
+		FailoverStepResyncPrepSource, FailoverStepSyncMirror, FailoverStepDisableMirrorPolicy,
+		FailoverStepAllowWritesOnSource, FailoverStepResyncPrepMirror, FailoverStepEnableSourcePolicy,
+	}, names)
+	assert.Contains(t, steps[1].Description, "policy_mirror")
+
+	assert.NoError(t, store.Save(ctx, &FailoverState{
+		Operation: PlannedFailover, PolicyName: "policy", CompletedSteps: []string{FailoverStepFinalSync},
+	}))
+	steps, err = w.Plan(ctx, PlannedFailover)
+	assert.NoError(t, err)
+	assert.True(t, steps[0].Done)
+	assert.False(t, steps[1].Done)
+
+	_, err = w.Plan(ctx, Failback)
+	assert.ErrorContains(t, err, "an unfinished planned_failover of policy policy must be completed first")
+
+	_, err = w.Plan(ctx, FailoverOperation("bogus"))
+	assert.ErrorContains(t, err, "unknown failover operation")
+
+	// no API calls are made while planning
+	source.API.(*mocks.Client).AssertExpectations(t)
+	target.API.(*mocks.Client).AssertExpectations(t)
+}
+
+func TestFailoverWorkflowResume(t *testing.T) {
+	ctx := context.Background()
+	source := &Client{API: new(mocks.Client)}
+	target := &Client{API: new(mocks.Client)}
+	store := &FileFailoverStateStore{Dir: t.TempDir()}
+	w := NewFailoverWorkflow(source, target, "policy")
+	w.Store = store
+
+	assert.NoError(t, store.Save(ctx, &FailoverState{
+		Operation: PlannedFailover, PolicyName: "policy", CompletedSteps: []string{FailoverStepFinalSync},
+	}))
+
+	// the source policy is disabled already and the target fails to report its state
+	mockPolicy(source.API.(*mocks.Client), apiv11.Policy{Name: "policy", Enabled: false})
+	target.API.(*mocks.Client).On("Get", anyArgs[:6]...).Return(errors.New("target unavailable")).Once()
+
+	err := w.PlannedFailover(ctx)
+	assert.ErrorContains(t, err, "planned_failover of policy policy failed at step allow-writes-on-target: target unavailable")
+	state, err := store.Load(ctx, "policy")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{FailoverStepFinalSync, FailoverStepDisableSourcePolicy}, state.CompletedSteps)
+
+	// the resumed run only allows writes, which are enabled already
+	mockTargetPolicy(target.API.(*mocks.Client), apiv11.TargetPolicy{Name: "policy", FailoverFailbackState: WritesEnabled}).Once()
+	assert.NoError(t, w.PlannedFailover(ctx))
+	state, err = store.Load(ctx, "policy")
+	assert.NoError(t, err)
+	assert.Nil(t, state)
+}
+
+func TestFailoverWorkflowStepTimeout(t *testing.T) {
+	ctx := context.Background()
+	source := &Client{API: new(mocks.Client)}
+	target := &Client{API: new(mocks.Client)}
+	w := NewFailoverWorkflow(source, target, "policy")
+	w.StepTimeouts = map[string]time.Duration{FailoverStepAllowWritesOnTarget: 10 * time.Millisecond}
+
+	mockTargetPolicy(target.API.(*mocks.Client), apiv11.TargetPolicy{Name: "policy", FailoverFailbackState: WritesDisabled})
+	target.API.(*mocks.Client).On("Post", anyArgs...).Return(nil).Once()
+
+	err := w.UnplannedFailover(ctx)
+	assert.ErrorIs(t, err, poll.ErrWaitTimeout)
+}
+
+func TestStepWaitOptions(t *testing.T) {
+	opts := stepWaitOptions(context.Background())
+	assert.Equal(t, DefaultWaitOptions(), opts)
+
+	// a step timeout beyond the default waiter timeout is not capped
+	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+	assert.Greater(t, stepWaitOptions(ctx).Timeout, 59*time.Minute)
+	w := &FailoverWorkflow{}
+	assert.Greater(t, w.stepSyncOptions(ctx).Wait.Timeout, 59*time.Minute)
+	assert.Equal(t, defaultSyncStartDelay, w.stepSyncOptions(ctx).StartDelay)
+	w.StartDelay = time.Millisecond
+	assert.Equal(t, time.Millisecond, w.stepSyncOptions(ctx).StartDelay)
+}
+
+func TestFailoverWorkflowFailback(t *testing.T) {
+	ctx := context.Background()
+	source := &Client{API: new(mocks.Client)}
+	target := &Client{API: new(mocks.Client)}
+	store := &FileFailoverStateStore{Dir: t.TempDir()}
+	w := NewFailoverWorkflow(source, target, "policy")
+	w.Store = store
+	w.StartDelay = time.Millisecond
+
+	var actions []string
+	for _, c := range []*Client{source, target} {
+		m := c.API.(*mocks.Client)
+		m.On("Get", mock.Anything, "/platform/11/sync/jobs/", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		m.On("Put", mock.Anything, "/platform/11/sync/policies/", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		m.On("Post", mock.Anything, "/platform/11/sync/jobs/", "", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			req := args.Get(5).(*apiv11.JobRequest)
+			actions = append(actions, req.ID+" "+string(req.Action))
+		})
+	}
+
+	// resync-prep of the source policy succeeds, the mirror policy is
+	// enabled, synced and disabled, but its resync-prep job fails
+	mockPolicy(source.API.(*mocks.Client), apiv11.Policy{Name: "policy", LastJobState: FINISHED}).Twice()
+	mirror := target.API.(*mocks.Client)
+	mockPolicy(mirror, apiv11.Policy{Name: "policy_mirror", Enabled: false}).Once()
+	mockPolicy(mirror, apiv11.Policy{Name: "policy_mirror", Enabled: true}).Times(3)
+	mockPolicy(mirror, apiv11.Policy{Name: "policy_mirror", Enabled: false}).Once()
+	mockPolicy(mirror, apiv11.Policy{Name: "policy_mirror", Enabled: false, LastJobState: FAILED}).Twice()
+	mockTargetPolicy(source.API.(*mocks.Client), apiv11.TargetPolicy{Name: "policy_mirror", FailoverFailbackState: WritesEnabled}).Once()
+
+	err := w.Failback(ctx)
+	assert.ErrorContains(t, err, "failback of policy policy failed at step resync-prep-mirror: resync-prep of policy policy_mirror ended in state failed")
+	state, err := store.Load(ctx, "policy")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		FailoverStepResyncPrepSource, FailoverStepSyncMirror, FailoverStepDisableMirrorPolicy, FailoverStepAllowWritesOnSource,
+	}, state.CompletedSteps)
+
+	// the resumed run repeats the resync-prep of the mirror policy and enables the source policy
+	mockPolicy(mirror, apiv11.Policy{Name: "policy_mirror", LastJobState: FINISHED}).Twice()
+	mockPolicy(source.API.(*mocks.Client), apiv11.Policy{Name: "policy", LastJobState: FINISHED}).Once()
+	mockPolicy(source.API.(*mocks.Client), apiv11.Policy{Name: "policy", Enabled: true}).Once()
+	assert.NoError(t, w.Failback(ctx))
+	state, err = store.Load(ctx, "policy")
+	assert.NoError(t, err)
+	assert.Nil(t, state)
+	assert.Equal(t, []string{
+		"policy resync_prep", "policy_mirror ", "policy_mirror resync_prep", "policy_mirror resync_prep",
+	}, actions)
+	source.API.(*mocks.Client).AssertNumberOfCalls(t, "Put", 1)
+	target.API.(*mocks.Client).AssertNumberOfCalls(t, "Put", 2)
+}
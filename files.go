@@ -0,0 +1,210 @@
+/*
+Copyright (c) 2025 Dell Inc, or its subsidiaries.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package goisilon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	apiv1 "github.com/dell/goisilon/api/v1"
+	apiv2 "github.com/dell/goisilon/api/v2"
+)
+
+// FileInfo is the metadata of a file returned with its content.
+type FileInfo apiv1.IsiFileInfo
+
+// DownloadOptions configures OpenFile.
+type DownloadOptions struct {
+	// Offset and Length select a range of the file, a zero Length reads to its end.
+	Offset int64
+	Length int64
+	// OnProgress is called with the number of bytes read so far.
+	OnProgress func(read int64)
+}
+
+// UploadOptions configures UploadFile.
+type UploadOptions struct {
+	// Mode is the mode of a created file, it defaults to 0644.
+	Mode apiv2.FileMode
+	// Overwrite replaces an existing file, otherwise uploading to an existing file fails.
+	Overwrite bool
+	// OnProgress is called with the number of bytes sent so far.
+	OnProgress func(sent int64)
+}
+
+const defaultUploadFileMode = apiv2.FileMode(0o644)
+
+// progressReader counts the bytes read through it.
+type progressReader struct {
+	r          io.Reader
+	n          int64
+	onProgress func(int64)
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.r.Read(b)
+	if n > 0 {
+		p.n += int64(n)
+		if p.onProgress != nil {
+			p.onProgress(p.n)
+		}
+	}
+	return n, err
+}
+
+// FileReader streams the content of a file.
+type FileReader struct {
+	io.Reader
+	// Info is the metadata of the file.
+	Info FileInfo
+
+	pipe *io.PipeReader
+}
+
+// Close stops the download.
+func (f *FileReader) Close() error {
+	return f.pipe.Close()
+}
+
+// OpenFile starts downloading the file at the absolute path isiPath and
+// returns a reader of its content once the cluster responded. The content is
+// streamed as it is read, so the reader must be closed.
+func (c *Client) OpenFile(ctx context.Context, isiPath string, opts *DownloadOptions) (*FileReader, error) {
+	if opts == nil {
+		opts = &DownloadOptions{}
+	}
+	pr, pw := io.Pipe()
+	infos := make(chan apiv1.IsiFileInfo, 1)
+	errs := make(chan error, 1)
+	go func() {
+		err := apiv1.GetIsiFileContent(ctx, c.API, isiPath,
+			apiv1.IsiFileRange{Offset: opts.Offset, Length: opts.Length}, pw,
+			func(info apiv1.IsiFileInfo) { infos <- info })
+		errs <- err
+		pw.CloseWithError(err)
+	}()
+
+	var info apiv1.IsiFileInfo
+	select {
+	case info = <-infos:
+	case err := <-errs:
+		if err != nil {
+			return nil, err
+		}
+		// an empty file can be copied before the info is received
+		select {
+		case info = <-infos:
+		default:
+			return nil, errors.New("no file content returned")
+		}
+	}
+	return &FileReader{
+		Reader: &progressReader{r: pr, onProgress: opts.OnProgress},
+		Info:   FileInfo(info),
+		pipe:   pr,
+	}, nil
+}
+
+// DownloadFile copies the content of the file at the absolute path isiPath to w
+// and returns the metadata of the file.
+func (c *Client) DownloadFile(ctx context.Context, isiPath string, w io.Writer, opts *DownloadOptions) (FileInfo, error) {
+	f, err := c.OpenFile(ctx, isiPath, opts)
+	if err != nil {
+		return FileInfo{}, err
+	}
+	defer f.Close()
+	_, err = io.Copy(w, f)
+	return f.Info, err
+}
+
+// UploadFile writes size bytes read from r to the file at the absolute path isiPath.
+func (c *Client) UploadFile(ctx context.Context, isiPath string, r io.Reader, size int64, opts *UploadOptions) error {
+	if opts == nil {
+		opts = &UploadOptions{}
+	}
+	mode := opts.Mode
+	if mode == 0 {
+		mode = defaultUploadFileMode
+	}
+	body := io.NopCloser(&progressReader{r: io.LimitReader(r, size), onProgress: opts.OnProgress})
+	return apiv1.PutIsiFileContent(ctx, c.API, isiPath, body, size, mode.String(), opts.Overwrite)
+}
+
+// CopyOptions configures Copy, see apiv1.IsiCopyOptions.
+type CopyOptions = apiv1.IsiCopyOptions
+
+// CopyErrors is returned by Copy when some entries could not be copied.
+type CopyErrors []apiv1.CopyError
+
+func (e CopyErrors) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, ce := range e {
+		msgs = append(msgs, fmt.Sprintf("%s: %s", ce.Source, ce.Message))
+	}
+	return fmt.Sprintf("failed to copy %d entries: %s", len(e), strings.Join(msgs, "; "))
+}
+
+// Move moves or renames the file or directory at the absolute path src to
+// dst on the cluster, without copying any data. dst must not exist.
+func (c *Client) Move(ctx context.Context, src, dst string) error {
+	return apiv1.MoveIsiNamespaceEntry(ctx, c.API, src, dst)
+}
+
+// Rename renames the file or directory at the absolute path isiPath to newName
+// within the same directory.
+func (c *Client) Rename(ctx context.Context, isiPath, newName string) error {
+	if newName == "" || strings.Contains(newName, "/") {
+		return fmt.Errorf("invalid name '%s'", newName)
+	}
+	return c.Move(ctx, isiPath, path.Join(path.Dir(path.Clean(isiPath)), newName))
+}
+
+// MoveVolumeWithIsiPath moves the volume name from the directory isiPath to
+// the directory newIsiPath and returns the moved volume.
+func (c *Client) MoveVolumeWithIsiPath(ctx context.Context, isiPath, name, newIsiPath string) (Volume, error) {
+	if err := c.Move(ctx, path.Join(isiPath, name), path.Join(newIsiPath, name)); err != nil {
+		return nil, err
+	}
+	return c.GetVolumeWithIsiPath(ctx, newIsiPath, "", name)
+}
+
+// Copy copies the file or directory at the absolute path src to dst on the
+// cluster. If some entries could not be copied, which with opts.Continue set
+// does not stop the copy, the returned error is a CopyErrors.
+func (c *Client) Copy(ctx context.Context, src, dst string, opts *CopyOptions) error {
+	if opts == nil {
+		opts = &CopyOptions{}
+	}
+	resp, err := apiv1.CopyIsiNamespaceEntry(ctx, c.API, src, dst, *opts)
+	if err != nil {
+		return err
+	}
+	if resp != nil && len(resp.CopyErrors) > 0 {
+		return CopyErrors(resp.CopyErrors)
+	}
+	return nil
+}
+
+// CloneFile clones the file at the absolute path src to dst, sharing its
+// blocks instead of copying them. snapshot, if set, is the snapshot to clone
+// the file from. Clones are only supported for files.
+func (c *Client) CloneFile(ctx context.Context, src, dst, snapshot string, overwrite bool) error {
+	return c.Copy(ctx, src, dst, &CopyOptions{Clone: true, Snapshot: snapshot, Overwrite: overwrite})
+}
@@ -0,0 +1,139 @@
+/*
+Copyright (c) 2025 Dell Inc, or its subsidiaries.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package goisilon
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/dell/goisilon/api"
+	apiv1 "github.com/dell/goisilon/api/v1"
+	"github.com/dell/goisilon/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestOpenFile(t *testing.T) {
+	ctx := context.Background()
+	client := &Client{API: new(mocks.Client)}
+
+	content := strings.Repeat("x", 64*1024)
+	client.API.(*mocks.Client).On("Get", ctx, "namespace", "ifs/data/big.bin", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*api.StreamResponse)
+		resp.OnHeader(http.StatusOK, http.Header{"Etag": {"v1"}, "Content-Length": {"65536"}})
+		_, _ = io.Copy(resp.Body, strings.NewReader(content))
+	}).Twice()
+
+	var read int64
+	f, err := client.OpenFile(ctx, "/ifs/data/big.bin", &DownloadOptions{OnProgress: func(n int64) { read = n }})
+	assert.NoError(t, err)
+	assert.Equal(t, "v1", f.Info.ETag)
+	assert.Equal(t, int64(len(content)), f.Info.Size)
+	data, err := io.ReadAll(f)
+	assert.NoError(t, err)
+	assert.NoError(t, f.Close())
+	assert.Equal(t, content, string(data))
+	assert.Equal(t, int64(len(content)), read)
+
+	// closing early stops the download
+	f, err = client.OpenFile(ctx, "/ifs/data/big.bin", nil)
+	assert.NoError(t, err)
+	_, err = f.Read(make([]byte, 10))
+	assert.NoError(t, err)
+	assert.NoError(t, f.Close())
+
+	client.API.(*mocks.Client).On("Get", ctx, "namespace", "ifs/data/missing", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("not found")).Once()
+	_, err = client.OpenFile(ctx, "/ifs/data/missing", nil)
+	assert.EqualError(t, err, "not found")
+}
+
+func TestDownloadAndUploadFile(t *testing.T) {
+	ctx := context.Background()
+	client := &Client{API: new(mocks.Client)}
+
+	client.API.(*mocks.Client).On("Get", ctx, "namespace", "ifs/data/empty", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		args.Get(5).(*api.StreamResponse).OnHeader(http.StatusOK, http.Header{"Etag": {"e"}})
+	}).Once()
+	var buf bytes.Buffer
+	info, err := client.DownloadFile(ctx, "/ifs/data/empty", &buf, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "e", info.ETag)
+	assert.Equal(t, 0, buf.Len())
+
+	client.API.(*mocks.Client).On("Put", ctx, "namespace", "ifs/data/config.yaml", api.OrderedValues(nil), mock.Anything, mock.Anything, nil).Return(nil).Run(func(args mock.Arguments) {
+		assert.Equal(t, "0644", args.Get(4).(map[string]string)["x-isi-ifs-access-control"])
+		data, _ := io.ReadAll(args.Get(5).(io.Reader))
+		assert.Equal(t, "key: value", string(data))
+	}).Once()
+	var sent int64
+	err = client.UploadFile(ctx, "/ifs/data/config.yaml", strings.NewReader("key: value"), 10,
+		&UploadOptions{Overwrite: true, OnProgress: func(n int64) { sent = n }})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), sent)
+}
+
+func TestMoveAndRename(t *testing.T) {
+	ctx := context.Background()
+	client := &Client{API: new(mocks.Client)}
+
+	client.API.(*mocks.Client).On("Post", ctx, "namespace", "ifs/data/old", mock.Anything,
+		map[string]string{"x-isi-ifs-set-location": "/namespace/ifs/data/new"}, nil, nil).Return(nil).Once()
+	assert.NoError(t, client.Rename(ctx, "/ifs/data/old", "new"))
+	assert.EqualError(t, client.Rename(ctx, "/ifs/data/old", "a/b"), "invalid name 'a/b'")
+
+	client.API.(*mocks.Client).On("Post", ctx, "namespace", "ifs/tenant1/vol", mock.Anything,
+		map[string]string{"x-isi-ifs-set-location": "/namespace/ifs/tenant2/vol"}, nil, nil).Return(nil).Once()
+	client.API.(*mocks.Client).On("Get", ctx, "namespace/ifs/tenant2", "vol", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		*args.Get(5).(**apiv1.GetIsiVolumeAttributesResp) = &apiv1.GetIsiVolumeAttributesResp{}
+	}).Once()
+	volume, err := client.MoveVolumeWithIsiPath(ctx, "/ifs/tenant1", "vol", "/ifs/tenant2")
+	assert.NoError(t, err)
+	assert.Equal(t, "vol", volume.Name)
+
+	client.API.(*mocks.Client).On("Post", anyArgs...).Return(errors.New("destination exists")).Once()
+	_, err = client.MoveVolumeWithIsiPath(ctx, "/ifs/tenant1", "vol", "/ifs/tenant2")
+	assert.EqualError(t, err, "destination exists")
+}
+
+func TestCopy(t *testing.T) {
+	ctx := context.Background()
+	client := &Client{API: new(mocks.Client)}
+
+	client.API.(*mocks.Client).On("Put", ctx, "namespace", "ifs/b", mock.Anything, mock.Anything, nil, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(6).(**apiv1.CopyIsiVolumesResp)
+		*resp = &apiv1.CopyIsiVolumesResp{CopyErrors: []apiv1.CopyError{
+			{Source: "/ifs/a/x", Message: "permission denied"},
+			{Source: "/ifs/a/y", Message: "file exists"},
+		}}
+	}).Once()
+	err := client.Copy(ctx, "/ifs/a", "/ifs/b", &CopyOptions{Merge: true, Continue: true})
+	var copyErrs CopyErrors
+	assert.True(t, errors.As(err, &copyErrs))
+	assert.Len(t, copyErrs, 2)
+	assert.EqualError(t, err, "failed to copy 2 entries: /ifs/a/x: permission denied; /ifs/a/y: file exists")
+
+	client.API.(*mocks.Client).On("Put", ctx, "namespace", "ifs/b/f", mock.Anything, mock.Anything, nil, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		params := args.Get(3).(api.OrderedValues)
+		assert.Equal(t, "overwrite=true&clone=true&snapshot=snap", params.Encode())
+	}).Once()
+	assert.NoError(t, client.CloneFile(ctx, "/ifs/a/f", "/ifs/b/f", "snap", true))
+}
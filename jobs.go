@@ -0,0 +1,225 @@
+/*
+Copyright (c) 2025 Dell Inc, or its subsidiaries.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package goisilon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	log "github.com/akutz/gournal"
+	"github.com/dell/goisilon/api"
+	"github.com/dell/goisilon/api/common/utils/poll"
+	apiv1 "github.com/dell/goisilon/api/v1"
+)
+
+// Job engine job states.
+const (
+	JobEngineStateRunning         = "running"
+	JobEngineStatePausedUser      = "paused_user"
+	JobEngineStatePausedSystem    = "paused_system"
+	JobEngineStatePausedPolicy    = "paused_policy"
+	JobEngineStatePausedPriority  = "paused_priority"
+	JobEngineStateCancelledUser   = "cancelled_user"
+	JobEngineStateCancelledSystem = "cancelled_system"
+	JobEngineStateFailed          = "failed"
+	JobEngineStateFailedNotRetry  = "failed_not_retried"
+	JobEngineStateSucceeded       = "succeeded"
+	JobEngineStateUnknown         = "unknown"
+)
+
+// Job engine job types started by this package.
+const (
+	JobTypeChangelistCreate = "ChangelistCreate"
+	JobTypeDomainMark       = "DomainMark"
+	JobTypeSnapRevert       = "SnapRevert"
+	JobTypeTreeDelete       = "TreeDelete"
+)
+
+// Job represents an Isilon job engine job.
+type Job *apiv1.IsiJob
+
+// isNotFoundError returns true if err is an API error with a 404 status code.
+func isNotFoundError(err error) bool {
+	var jsonErr *api.JSONError
+	if errors.As(err, &jsonErr) {
+		return jsonErr.StatusCode == http.StatusNotFound
+	}
+	var htmlErr *api.HTMLError
+	if errors.As(err, &htmlErr) {
+		return htmlErr.StatusCode == http.StatusNotFound
+	}
+	return false
+}
+
+// isBadRequestError returns true if err is a bad request response of the API.
+func isBadRequestError(err error) bool {
+	var jsonErr *api.JSONError
+	return errors.As(err, &jsonErr) && jsonErr.StatusCode == http.StatusBadRequest
+}
+
+// IsJobFinished returns true if the job engine job is no longer running or paused.
+func IsJobFinished(job Job) bool {
+	switch job.State {
+	case JobEngineStateSucceeded, JobEngineStateFailed, JobEngineStateFailedNotRetry,
+		JobEngineStateCancelledUser, JobEngineStateCancelledSystem:
+		return true
+	}
+	return false
+}
+
+// StartJob queues a new job engine job and returns it.
+func (c *Client) StartJob(ctx context.Context, req *apiv1.IsiJobReq) (Job, error) {
+	id, err := apiv1.StartIsiJob(ctx, c.API, req)
+	if err != nil {
+		return nil, err
+	}
+	log.Debug(ctx, "started %s job %d", req.Type, id)
+	return c.GetJob(ctx, id)
+}
+
+// jobEventStates maps the job state changes recorded as job events, e.g.
+// "Succeeded" or "Cancelled user", to job engine job states.
+var jobEventStates = map[string]string{
+	"running":            JobEngineStateRunning,
+	"paused":             JobEngineStatePausedUser,
+	"paused_user":        JobEngineStatePausedUser,
+	"paused_system":      JobEngineStatePausedSystem,
+	"paused_policy":      JobEngineStatePausedPolicy,
+	"paused_priority":    JobEngineStatePausedPriority,
+	"cancelled":          JobEngineStateCancelledUser,
+	"cancelled_user":     JobEngineStateCancelledUser,
+	"cancelled_system":   JobEngineStateCancelledSystem,
+	"failed":             JobEngineStateFailed,
+	"failed_not_retried": JobEngineStateFailedNotRetry,
+	"succeeded":          JobEngineStateSucceeded,
+}
+
+// jobEventState returns the job state a job event records a change to, or ""
+// if the event is not a state change.
+func jobEventState(event *apiv1.IsiJobEvent) string {
+	candidates := []string{event.Key}
+	if value, ok := event.Value.(string); ok {
+		candidates = append(candidates, value)
+	}
+	for _, candidate := range candidates {
+		name := strings.ReplaceAll(strings.ToLower(strings.TrimSpace(candidate)), " ", "_")
+		if state, ok := jobEventStates[name]; ok {
+			return state
+		}
+	}
+	return ""
+}
+
+// GetJob returns a job engine job by ID. Jobs that have already finished are
+// no longer listed by the job engine, in that case the job is reconstructed
+// from the last state change recorded in its events. The not found error is
+// returned if the events record none.
+func (c *Client) GetJob(ctx context.Context, id int64) (Job, error) {
+	job, err := apiv1.GetIsiJob(ctx, c.API, id)
+	if err == nil {
+		return job, nil
+	}
+	if !isNotFoundError(err) {
+		return nil, err
+	}
+
+	events, eventErr := apiv1.GetIsiJobEvents(ctx, c.API, id)
+	if eventErr != nil {
+		return nil, eventErr
+	}
+	for i := len(events) - 1; i >= 0; i-- {
+		state := jobEventState(events[i])
+		if state == "" {
+			continue
+		}
+		return &apiv1.IsiJob{
+			ID:           id,
+			Type:         events[i].JobType,
+			State:        state,
+			CurrentPhase: events[i].Phase,
+			EndTime:      events[i].Time,
+		}, nil
+	}
+	return nil, err
+}
+
+// PauseJob pauses a running job engine job.
+func (c *Client) PauseJob(ctx context.Context, id int64) error {
+	return apiv1.UpdateIsiJobState(ctx, c.API, id, "paused")
+}
+
+// ResumeJob resumes a paused job engine job.
+func (c *Client) ResumeJob(ctx context.Context, id int64) error {
+	return apiv1.UpdateIsiJobState(ctx, c.API, id, "running")
+}
+
+// CancelJob cancels a running or paused job engine job.
+func (c *Client) CancelJob(ctx context.Context, id int64) error {
+	return apiv1.UpdateIsiJobState(ctx, c.API, id, "cancelled")
+}
+
+// WaitForJob polls a job engine job until it finishes and returns its final
+// state. onProgress, if set, is called with the job each time it is polled.
+// An error is returned if the job did not succeed.
+//
+// The poll interval is 5 seconds, there is no timeout other than the one set on ctx.
+func (c *Client) WaitForJob(ctx context.Context, id int64, onProgress func(Job)) (Job, error) {
+	var last Job
+	opts := poll.Options[Job]{Backoff: poll.Backoff{Interval: defaultPoll}}
+	job, pollErr := poll.PollUntil(ctx, opts, func(iCtx context.Context) (Job, bool, error) {
+		j, err := c.GetJob(iCtx, id)
+		if err != nil {
+			return last, false, err
+		}
+		last = j
+		if onProgress != nil {
+			onProgress(j)
+		}
+		return j, IsJobFinished(j), nil
+	})
+	if pollErr != nil {
+		return job, pollErr
+	}
+
+	if job.State != JobEngineStateSucceeded {
+		return job, c.jobFailure(ctx, job)
+	}
+	return job, nil
+}
+
+// jobFailure builds an error describing why a job engine job did not succeed
+// from the events recorded for it.
+func (c *Client) jobFailure(ctx context.Context, job Job) error {
+	msg := fmt.Sprintf("%s job %d finished in state %s", job.Type, job.ID, job.State)
+	events, err := apiv1.GetIsiJobEvents(ctx, c.API, job.ID)
+	if err != nil {
+		log.Warn(ctx, "unable to get events for job %d: %v", job.ID, err)
+		return errors.New(msg)
+	}
+	var details []string
+	for _, event := range events {
+		if event.Value != nil {
+			details = append(details, fmt.Sprintf("%v", event.Value))
+		}
+	}
+	if len(details) > 0 {
+		msg = fmt.Sprintf("%s: %s", msg, strings.Join(details, "; "))
+	}
+	return errors.New(msg)
+}
@@ -0,0 +1,201 @@
+/*
+Copyright (c) 2025 Dell Inc, or its subsidiaries.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package goisilon
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/dell/goisilon/api"
+	apiv1 "github.com/dell/goisilon/api/v1"
+	"github.com/dell/goisilon/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func mockJobState(c *mocks.Client, id, state string) *mock.Call {
+	return c.On("Get", mock.Anything, "platform/1/job/jobs", id, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*apiv1.IsiJobsResp)
+		resp.Jobs = []*apiv1.IsiJob{{ID: 42, Type: JobTypeSnapRevert, State: state}}
+	})
+}
+
+func TestGetJob(t *testing.T) {
+	client := &Client{API: new(mocks.Client)}
+	ctx := context.Background()
+	notFound := &api.JSONError{StatusCode: 404, Err: []api.Error{{Message: "not found"}}}
+
+	mockJobState(client.API.(*mocks.Client), "42", JobEngineStateRunning).Once()
+	job, err := client.GetJob(ctx, 42)
+	assert.Nil(t, err)
+	assert.Equal(t, JobEngineStateRunning, job.State)
+
+	// finished jobs are looked up through their events
+	client.API.(*mocks.Client).On("Get", ctx, "platform/1/job/jobs", "42", mock.Anything, mock.Anything, mock.Anything).
+		Return(notFound).Once()
+	client.API.(*mocks.Client).On("Get", ctx, "platform/1/job/events", "", mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*apiv1.IsiJobEventsResp)
+		resp.Events = []*apiv1.IsiJobEvent{
+			{JobID: 42, JobType: JobTypeSnapRevert, Key: "Running"},
+			{JobID: 42, JobType: JobTypeSnapRevert, Key: "Cancelled user"},
+			{JobID: 42, JobType: JobTypeSnapRevert, Phase: 1, Key: "Phase 1: end", Value: "lin scan"},
+		}
+	}).Once()
+	job, err = client.GetJob(ctx, 42)
+	assert.Nil(t, err)
+	assert.Equal(t, JobEngineStateCancelledUser, job.State)
+	assert.True(t, IsJobFinished(job))
+
+	// without a recorded state change the job is not found
+	client.API.(*mocks.Client).On("Get", ctx, "platform/1/job/jobs", "44", mock.Anything, mock.Anything, mock.Anything).
+		Return(notFound).Once()
+	client.API.(*mocks.Client).On("Get", ctx, "platform/1/job/events", "", mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*apiv1.IsiJobEventsResp)
+		resp.Events = []*apiv1.IsiJobEvent{{JobID: 44, Key: "Phase 1: begin", Value: "lin scan"}}
+	}).Once()
+	_, err = client.GetJob(ctx, 44)
+	assert.True(t, isNotFoundError(err))
+
+	client.API.(*mocks.Client).On("Get", ctx, "platform/1/job/jobs", "43", mock.Anything, mock.Anything, mock.Anything).
+		Return(fmt.Errorf("network error")).Once()
+	_, err = client.GetJob(ctx, 43)
+	assert.EqualError(t, err, "network error")
+}
+
+func TestJobControl(t *testing.T) {
+	client := &Client{API: new(mocks.Client)}
+	ctx := context.Background()
+
+	client.API.(*mocks.Client).On("Put", ctx, "platform/1/job/jobs", "42", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).Times(3)
+	assert.Nil(t, client.PauseJob(ctx, 42))
+	assert.Nil(t, client.ResumeJob(ctx, 42))
+	assert.Nil(t, client.CancelJob(ctx, 42))
+	client.API.(*mocks.Client).AssertExpectations(t)
+}
+
+func TestWaitForJob(t *testing.T) {
+	client := &Client{API: new(mocks.Client)}
+	ctx := context.Background()
+
+	mockJobState(client.API.(*mocks.Client), "42", JobEngineStateSucceeded).Once()
+	var polled int
+	job, err := client.WaitForJob(ctx, 42, func(Job) { polled++ })
+	assert.Nil(t, err)
+	assert.Equal(t, JobEngineStateSucceeded, job.State)
+	assert.Equal(t, 1, polled)
+
+	mockJobState(client.API.(*mocks.Client), "0", JobEngineStateRunning).Once()
+	mockJobState(client.API.(*mocks.Client), "42", JobEngineStateFailed).Once()
+	client.API.(*mocks.Client).On("Get", ctx, "platform/1/job/events", "", mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*apiv1.IsiJobEventsResp)
+		resp.Events = []*apiv1.IsiJobEvent{{JobID: 42, Key: "Error", Value: "path is not in a SnapRevert domain"}}
+	}).Once()
+	_, err = client.WaitForJob(ctx, 42, nil)
+	assert.EqualError(t, err, "SnapRevert job 42 finished in state failed: path is not in a SnapRevert domain")
+}
+
+func TestRestoreSnapshot(t *testing.T) {
+	client := &Client{API: new(mocks.Client)}
+	ctx := context.Background()
+
+	client.API.(*mocks.Client).On("Get", ctx, "platform/1/snapshot/snapshots/7", "", mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(**apiv1.GetIsiSnapshotsResp)
+		*resp = &apiv1.GetIsiSnapshotsResp{SnapshotList: []*apiv1.IsiSnapshot{{ID: 7, Name: "snap", Path: "/ifs/data/vol"}}}
+	})
+	var started []string
+	client.API.(*mocks.Client).On("Post", ctx, "platform/1/job/jobs", "", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).Run(func(args mock.Arguments) {
+		req := args.Get(5).(*apiv1.IsiJobReq)
+		started = append(started, req.Type)
+		if req.Type == JobTypeDomainMark {
+			assert.Equal(t, "/ifs/data/vol", req.DomainmarkParams.Root)
+		} else {
+			assert.Equal(t, int64(7), req.SnaprevertParams.Snapid)
+		}
+	})
+	mockJobState(client.API.(*mocks.Client), "0", JobEngineStateSucceeded)
+	mockJobState(client.API.(*mocks.Client), "42", JobEngineStateSucceeded)
+
+	job, err := client.RestoreSnapshot(ctx, 7, "", &RestoreSnapshotOptions{CreateDomain: true})
+	assert.Nil(t, err)
+	assert.Equal(t, JobEngineStateSucceeded, job.State)
+	assert.Equal(t, []string{JobTypeDomainMark, JobTypeSnapRevert}, started)
+
+	started = nil
+	_, err = client.RestoreSnapshot(ctx, 7, "", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{JobTypeSnapRevert}, started)
+
+	// the domain is created when the path has none
+	client = &Client{API: new(mocks.Client)}
+	client.API.(*mocks.Client).On("Get", ctx, "platform/1/snapshot/snapshots/7", "", mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(**apiv1.GetIsiSnapshotsResp)
+		*resp = &apiv1.GetIsiSnapshotsResp{SnapshotList: []*apiv1.IsiSnapshot{{ID: 7, Name: "snap", Path: "/ifs/data/vol"}}}
+	})
+	noDomain := &api.JSONError{StatusCode: http.StatusBadRequest, Err: []api.Error{{Code: "AEC_BAD_REQUEST"}}}
+	started = nil
+	client.API.(*mocks.Client).On("Post", ctx, "platform/1/job/jobs", "", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(noDomain).Run(func(args mock.Arguments) {
+		started = append(started, args.Get(5).(*apiv1.IsiJobReq).Type)
+	}).Once()
+	client.API.(*mocks.Client).On("Post", ctx, "platform/1/job/jobs", "", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).Run(func(args mock.Arguments) {
+		started = append(started, args.Get(5).(*apiv1.IsiJobReq).Type)
+	})
+	mockJobState(client.API.(*mocks.Client), "0", JobEngineStateSucceeded)
+	mockJobState(client.API.(*mocks.Client), "42", JobEngineStateSucceeded)
+	_, err = client.RestoreSnapshot(ctx, 7, "", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{JobTypeSnapRevert, JobTypeDomainMark, JobTypeSnapRevert}, started)
+
+	// other refusals and failed jobs are not retried, whatever their message
+	unavailable := &api.JSONError{StatusCode: http.StatusServiceUnavailable, Err: []api.Error{{Message: "domain not found"}}}
+	client.API.(*mocks.Client).ExpectedCalls = nil
+	client.API.(*mocks.Client).On("Get", ctx, "platform/1/snapshot/snapshots/7", "", mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(**apiv1.GetIsiSnapshotsResp)
+		*resp = &apiv1.GetIsiSnapshotsResp{SnapshotList: []*apiv1.IsiSnapshot{{ID: 7, Name: "snap", Path: "/ifs/data/vol"}}}
+	})
+	client.API.(*mocks.Client).On("Post", ctx, "platform/1/job/jobs", "", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(unavailable).Once()
+	_, err = client.RestoreSnapshot(ctx, 7, "", nil)
+	assert.Equal(t, unavailable, err)
+
+	started = nil
+	client.API.(*mocks.Client).On("Post", ctx, "platform/1/job/jobs", "", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).Run(func(args mock.Arguments) {
+		started = append(started, args.Get(5).(*apiv1.IsiJobReq).Type)
+	})
+	mockJobState(client.API.(*mocks.Client), "0", JobEngineStateRunning).Once()
+	mockJobState(client.API.(*mocks.Client), "42", JobEngineStateFailed).Once()
+	client.API.(*mocks.Client).On("Get", ctx, "platform/1/job/events", "", mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*apiv1.IsiJobEventsResp)
+		resp.Events = []*apiv1.IsiJobEvent{{JobID: 42, Key: "Error", Value: "path is not in a SnapRevert domain"}}
+	}).Once()
+	_, err = client.RestoreSnapshot(ctx, 7, "", nil)
+	assert.ErrorContains(t, err, "finished in state failed")
+	assert.Equal(t, []string{JobTypeSnapRevert}, started)
+}
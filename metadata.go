@@ -0,0 +1,224 @@
+/*
+Copyright (c) 2025 Dell Inc, or its subsidiaries.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package goisilon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"path"
+	"strconv"
+	"time"
+
+	apiv1 "github.com/dell/goisilon/api/v1"
+)
+
+// SystemAttributes are the typed system attributes of a file or directory.
+type SystemAttributes struct {
+	Name        string
+	Type        string
+	Size        int64
+	Owner       string
+	Group       string
+	UID         int
+	GID         int
+	Mode        fs.FileMode
+	ModTime     time.Time
+	ChangeTime  time.Time
+	AccessTime  time.Time
+	CreateTime  time.Time
+	ContentType string
+	IsHidden    bool
+	// AccessPattern is the access pattern of the entry, e.g. concurrency, streaming or random.
+	AccessPattern string
+	// ProtectionLevel is the requested data protection level of the entry, e.g. default or +2d:1n.
+	ProtectionLevel string
+}
+
+// attrString returns the value of a metadata attribute as a string.
+func attrString(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	return fmt.Sprint(v)
+}
+
+// attrInt returns the value of a numeric metadata attribute.
+func attrInt(v interface{}) int64 {
+	switch v := v.(type) {
+	case float64:
+		return int64(v)
+	case string:
+		i, _ := strconv.ParseInt(v, 10, 64)
+		return i
+	}
+	return 0
+}
+
+func attrTime(v interface{}) time.Time {
+	t, _ := http.ParseTime(attrString(v))
+	return t
+}
+
+// GetUserMetadata returns the user-defined metadata of the file or directory
+// at the absolute path isiPath.
+func (c *Client) GetUserMetadata(ctx context.Context, isiPath string) (map[string]string, error) {
+	attrs, err := apiv1.GetIsiMetadata(ctx, c.API, isiPath)
+	if err != nil {
+		return nil, err
+	}
+	metadata := make(map[string]string)
+	for _, attr := range attrs {
+		if attr.Namespace == apiv1.IsiUserMetadataNamespace {
+			metadata[attr.Name] = attrString(attr.Value)
+		}
+	}
+	return metadata, nil
+}
+
+func userMetadataAttrs(metadata map[string]string) []apiv1.IsiMetadataAttr {
+	attrs := make([]apiv1.IsiMetadataAttr, 0, len(metadata))
+	for name, value := range metadata {
+		attrs = append(attrs, apiv1.IsiMetadataAttr{
+			Name:      name,
+			Value:     value,
+			Namespace: apiv1.IsiUserMetadataNamespace,
+			Op:        "update",
+		})
+	}
+	return attrs
+}
+
+// SetUserMetadata adds or updates the given user-defined metadata of the file
+// or directory at the absolute path isiPath, keeping its other keys.
+func (c *Client) SetUserMetadata(ctx context.Context, isiPath string, metadata map[string]string) error {
+	if len(metadata) == 0 {
+		return nil
+	}
+	return apiv1.UpdateIsiMetadata(ctx, c.API, isiPath, &apiv1.IsiMetadataUpdate{
+		Action: "update",
+		Attrs:  userMetadataAttrs(metadata),
+	})
+}
+
+// ReplaceUserMetadata replaces all user-defined metadata of the file or
+// directory at the absolute path isiPath with metadata.
+func (c *Client) ReplaceUserMetadata(ctx context.Context, isiPath string, metadata map[string]string) error {
+	return apiv1.UpdateIsiMetadata(ctx, c.API, isiPath, &apiv1.IsiMetadataUpdate{
+		Action: "replace",
+		Attrs:  userMetadataAttrs(metadata),
+	})
+}
+
+// DeleteUserMetadata deletes the given keys from the user-defined metadata of
+// the file or directory at the absolute path isiPath.
+func (c *Client) DeleteUserMetadata(ctx context.Context, isiPath string, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	attrs := make([]apiv1.IsiMetadataAttr, 0, len(keys))
+	for _, key := range keys {
+		attrs = append(attrs, apiv1.IsiMetadataAttr{
+			Name:      key,
+			Namespace: apiv1.IsiUserMetadataNamespace,
+			Op:        "delete",
+		})
+	}
+	return apiv1.UpdateIsiMetadata(ctx, c.API, isiPath, &apiv1.IsiMetadataUpdate{
+		Action: "update",
+		Attrs:  attrs,
+	})
+}
+
+// GetSystemAttributes returns the system attributes of the file or directory
+// at the absolute path isiPath, including its protection settings.
+func (c *Client) GetSystemAttributes(ctx context.Context, isiPath string) (*SystemAttributes, error) {
+	attrs, err := apiv1.GetIsiMetadata(ctx, c.API, isiPath)
+	if err != nil {
+		return nil, err
+	}
+	sys := &SystemAttributes{Name: path.Base(path.Clean(isiPath))}
+	for _, attr := range attrs {
+		if attr.Namespace == apiv1.IsiUserMetadataNamespace {
+			continue
+		}
+		switch attr.Name {
+		case "name":
+			sys.Name = attrString(attr.Value)
+		case "type":
+			sys.Type = attrString(attr.Value)
+		case "size":
+			sys.Size = attrInt(attr.Value)
+		case "owner":
+			sys.Owner = attrString(attr.Value)
+		case "group":
+			sys.Group = attrString(attr.Value)
+		case "uid":
+			sys.UID = int(attrInt(attr.Value))
+		case "gid":
+			sys.GID = int(attrInt(attr.Value))
+		case "mode":
+			perm, _ := strconv.ParseUint(attrString(attr.Value), 8, 32)
+			sys.Mode = fs.FileMode(perm) & fs.ModePerm
+		case "last_modified":
+			sys.ModTime = attrTime(attr.Value)
+		case "change_time":
+			sys.ChangeTime = attrTime(attr.Value)
+		case "access_time":
+			sys.AccessTime = attrTime(attr.Value)
+		case "create_time":
+			sys.CreateTime = attrTime(attr.Value)
+		case "content_type":
+			sys.ContentType = attrString(attr.Value)
+		case "is_hidden":
+			sys.IsHidden, _ = attr.Value.(bool)
+		}
+	}
+
+	protection, err := apiv1.GetIsiProtection(ctx, c.API, isiPath)
+	if err != nil {
+		return nil, err
+	}
+	sys.AccessPattern = protection.AccessPattern
+	sys.ProtectionLevel = protection.Level
+	return sys, nil
+}
+
+// FindVolumesByMetadata returns the names of the volumes whose user-defined
+// metadata maps key to value, using the namespace query API.
+func (c *Client) FindVolumesByMetadata(ctx context.Context, key, value string) ([]string, error) {
+	if key == "" {
+		return nil, errors.New("no metadata key set")
+	}
+	q := c.NewQuery(c.API.VolumesPath()).
+		Where(IsDir(), WhereUserMetadata(key, QueryOpEqual, value)).
+		MaxDepth(1)
+	var names []string
+	for e, err := range q.Entries(ctx) {
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, e.Entry.Name())
+	}
+	return names, nil
+}
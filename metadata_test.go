@@ -0,0 +1,118 @@
+/*
+Copyright (c) 2025 Dell Inc, or its subsidiaries.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package goisilon
+
+import (
+	"context"
+	"encoding/json"
+	"io/fs"
+	"testing"
+	"time"
+
+	apiv1 "github.com/dell/goisilon/api/v1"
+	apiv2 "github.com/dell/goisilon/api/v2"
+	"github.com/dell/goisilon/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func mockMetadata(t *testing.T, c *mocks.Client, id, attrs string) {
+	c.On("Get", mock.Anything, "namespace", id, mock.Anything, mock.Anything, mock.AnythingOfType("*v1.GetIsiVolumeAttributesResp")).Return(nil).Run(func(args mock.Arguments) {
+		assert.NoError(t, json.Unmarshal([]byte(`{"attrs":`+attrs+`}`), args.Get(5)))
+	}).Once()
+}
+
+func TestUserMetadata(t *testing.T) {
+	ctx := context.Background()
+	client := &Client{API: new(mocks.Client)}
+
+	mockMetadata(t, client.API.(*mocks.Client), "ifs/data/vol", `[
+		{"name":"size","value":0},
+		{"name":"owner","value":"root","namespace":"user"},
+		{"name":"tier","value":"gold","namespace":"user"}]`)
+	metadata, err := client.GetUserMetadata(ctx, "/ifs/data/vol")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"owner": "root", "tier": "gold"}, metadata)
+
+	client.API.(*mocks.Client).On("Put", ctx, "namespace", "ifs/data/vol", mock.Anything, mock.Anything, &apiv1.IsiMetadataUpdate{
+		Action: "update",
+		Attrs:  []apiv1.IsiMetadataAttr{{Name: "tier", Value: "silver", Namespace: "user", Op: "update"}},
+	}, nil).Return(nil).Once()
+	assert.NoError(t, client.SetUserMetadata(ctx, "/ifs/data/vol", map[string]string{"tier": "silver"}))
+
+	client.API.(*mocks.Client).On("Put", ctx, "namespace", "ifs/data/vol", mock.Anything, mock.Anything, &apiv1.IsiMetadataUpdate{
+		Action: "replace",
+		Attrs:  []apiv1.IsiMetadataAttr{},
+	}, nil).Return(nil).Once()
+	assert.NoError(t, client.ReplaceUserMetadata(ctx, "/ifs/data/vol", nil))
+
+	client.API.(*mocks.Client).On("Put", ctx, "namespace", "ifs/data/vol", mock.Anything, mock.Anything, &apiv1.IsiMetadataUpdate{
+		Action: "update",
+		Attrs:  []apiv1.IsiMetadataAttr{{Name: "tier", Namespace: "user", Op: "delete"}},
+	}, nil).Return(nil).Once()
+	assert.NoError(t, client.DeleteUserMetadata(ctx, "/ifs/data/vol", "tier"))
+	assert.NoError(t, client.DeleteUserMetadata(ctx, "/ifs/data/vol"))
+	client.API.(*mocks.Client).AssertExpectations(t)
+}
+
+func TestGetSystemAttributes(t *testing.T) {
+	ctx := context.Background()
+	client := &Client{API: new(mocks.Client)}
+
+	mockMetadata(t, client.API.(*mocks.Client), "ifs/data/file.txt", `[
+		{"name":"type","value":"object"},{"name":"size","value":42},{"name":"owner","value":"root"},
+		{"name":"uid","value":0},{"name":"gid","value":10},{"name":"mode","value":"0640"},
+		{"name":"last_modified","value":"Thu, 02 Jan 2025 03:04:05 GMT"},{"name":"is_hidden","value":false},
+		{"name":"size","value":"user size","namespace":"user"}]`)
+	client.API.(*mocks.Client).On("Get", ctx, "namespace", "ifs/data/file.txt", mock.Anything, mock.Anything, mock.AnythingOfType("*v1.IsiProtection")).Return(nil).Run(func(args mock.Arguments) {
+		*args.Get(5).(*apiv1.IsiProtection) = apiv1.IsiProtection{AccessPattern: "streaming", Level: "+2d:1n"}
+	}).Once()
+	sys, err := client.GetSystemAttributes(ctx, "/ifs/data/file.txt")
+	assert.NoError(t, err)
+	assert.Equal(t, &SystemAttributes{
+		Name:            "file.txt",
+		Type:            "object",
+		Size:            42,
+		Owner:           "root",
+		GID:             10,
+		Mode:            fs.FileMode(0o640),
+		ModTime:         time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+		AccessPattern:   "streaming",
+		ProtectionLevel: "+2d:1n",
+	}, sys)
+}
+
+func TestFindVolumesByMetadata(t *testing.T) {
+	ctx := context.Background()
+	client := &Client{API: new(mocks.Client)}
+
+	client.API.(*mocks.Client).On("VolumesPath", anyArgs[0:6]...).Return("/ifs/volumes").Once()
+	client.API.(*mocks.Client).On("Post", ctx, "namespace", "ifs/volumes", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		query := args.Get(5).(*apiv2.ContainerQuery)
+		assert.Equal(t, &apiv2.ContainerQueryScopeCondition{Operator: "=", Attr: "tier", Value: "gold", Namespace: "user"}, query.Scope.Conditions[1])
+		assert.NoError(t, json.Unmarshal([]byte(`{"children":[{"name":"vol1"}],"resume":"next"}`), args.Get(6)))
+	}).Once()
+	client.API.(*mocks.Client).On("Post", ctx, "namespace", "ifs/volumes", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		assert.NoError(t, json.Unmarshal([]byte(`{"children":[{"name":"vol2"}]}`), args.Get(6)))
+	}).Once()
+	names, err := client.FindVolumesByMetadata(ctx, "tier", "gold")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"vol1", "vol2"}, names)
+
+	_, err = client.FindVolumesByMetadata(ctx, "", "gold")
+	assert.EqualError(t, err, "no metadata key set")
+}
@@ -0,0 +1,278 @@
+/*
+Copyright (c) 2025 Dell Inc, or its subsidiaries.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package goisilon
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"path"
+	"sort"
+
+	apiv1 "github.com/dell/goisilon/api/v1"
+)
+
+// NamespaceFS is a read-only fs.FS of a OneFS directory tree, accessed
+// through the namespace API. It implements fs.StatFS, fs.ReadDirFS and
+// fs.ReadFileFS. Opened files support io.Seeker and io.ReaderAt, so the FS
+// can be served with http.FileServer.
+type NamespaceFS struct {
+	c    *Client
+	ctx  context.Context
+	root string
+}
+
+var (
+	_ fs.StatFS     = (*NamespaceFS)(nil)
+	_ fs.ReadDirFS  = (*NamespaceFS)(nil)
+	_ fs.ReadFileFS = (*NamespaceFS)(nil)
+	_ fs.SubFS      = (*NamespaceFS)(nil)
+)
+
+// FS returns a read-only fs.FS of the directory tree at the absolute path root.
+// ctx is used for every request of the FS and the files opened from it.
+func (c *Client) FS(ctx context.Context, root string) *NamespaceFS {
+	return &NamespaceFS{c: c, ctx: ctx, root: path.Clean(root)}
+}
+
+// SnapshotFS returns a read-only fs.FS of the directory isiPath as it was
+// captured by a snapshot, see GetSnapshotIsiPath.
+func (c *Client) SnapshotFS(ctx context.Context, isiPath, snapshotID, accessZone string) (*NamespaceFS, error) {
+	snapshotPath, err := c.GetSnapshotIsiPath(ctx, isiPath, snapshotID, accessZone)
+	if err != nil {
+		return nil, err
+	}
+	return c.FS(ctx, snapshotPath), nil
+}
+
+// Root returns the absolute path of the root of the FS.
+func (f *NamespaceFS) Root() string {
+	return f.root
+}
+
+// isiPath returns the absolute path of name, or an error if name is not valid.
+func (f *NamespaceFS) isiPath(op, name string) (string, error) {
+	if !fs.ValidPath(name) {
+		return "", &fs.PathError{Op: op, Path: name, Err: fs.ErrInvalid}
+	}
+	return path.Join(f.root, name), nil
+}
+
+// pathError wraps err as returned by the namespace API for name.
+func pathError(op, name string, err error) error {
+	if isNotFoundError(err) {
+		err = fs.ErrNotExist
+	}
+	return &fs.PathError{Op: op, Path: name, Err: err}
+}
+
+// Stat returns the attributes of the named file or directory.
+func (f *NamespaceFS) Stat(name string) (fs.FileInfo, error) {
+	p, err := f.isiPath("stat", name)
+	if err != nil {
+		return nil, err
+	}
+	entry, err := apiv1.GetIsiNamespaceEntry(f.ctx, f.c.API, p)
+	if err != nil {
+		return nil, pathError("stat", name, err)
+	}
+	if name == "." {
+		entry.Name = "."
+	}
+	return &DirEntry{*entry}, nil
+}
+
+// Open opens the named file or directory.
+func (f *NamespaceFS) Open(name string) (fs.File, error) {
+	info, err := f.Stat(name)
+	if err != nil {
+		if pe, ok := err.(*fs.PathError); ok {
+			pe.Op = "open"
+		}
+		return nil, err
+	}
+	p, _ := f.isiPath("open", name)
+	d := info.(*DirEntry)
+	if d.IsDir() {
+		return &namespaceDir{fs: f, path: p, info: d}, nil
+	}
+	return &namespaceFile{fs: f, path: p, info: d}, nil
+}
+
+// ReadDir returns the entries of the named directory sorted by name.
+func (f *NamespaceFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	p, err := f.isiPath("readdir", name)
+	if err != nil {
+		return nil, err
+	}
+	dir := &namespaceDir{fs: f, path: p}
+	entries, err := dir.ReadDir(-1)
+	if err != nil {
+		// listing a file returns its content, which fails to decode
+		if !isNotFoundError(err) {
+			if entry, statErr := apiv1.GetIsiNamespaceEntry(f.ctx, f.c.API, p); statErr == nil &&
+				entry.Type != apiv1.IsiNamespaceEntryTypeContainer {
+				err = errors.New("not a directory")
+			}
+		}
+		return nil, pathError("readdir", name, err)
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name() < entries[j].Name() })
+	return entries, nil
+}
+
+// ReadFile returns the content of the named file.
+func (f *NamespaceFS) ReadFile(name string) ([]byte, error) {
+	p, err := f.isiPath("readfile", name)
+	if err != nil {
+		return nil, err
+	}
+	r, err := f.c.OpenFile(f.ctx, p, nil)
+	if err != nil {
+		return nil, pathError("readfile", name, err)
+	}
+	defer r.Close()
+	return io.ReadAll(r)
+}
+
+// Sub returns the FS of the named subdirectory.
+func (f *NamespaceFS) Sub(dir string) (fs.FS, error) {
+	p, err := f.isiPath("sub", dir)
+	if err != nil {
+		return nil, err
+	}
+	return &NamespaceFS{c: f.c, ctx: f.ctx, root: p}, nil
+}
+
+// namespaceDir is an open directory of a NamespaceFS.
+type namespaceDir struct {
+	fs      *NamespaceFS
+	path    string
+	info    *DirEntry
+	pending []apiv1.IsiNamespaceEntry
+	resume  string
+	started bool
+}
+
+func (d *namespaceDir) Stat() (fs.FileInfo, error) { return d.info, nil }
+
+func (d *namespaceDir) Read([]byte) (int, error) {
+	return 0, &fs.PathError{Op: "read", Path: d.path, Err: errors.New("is a directory")}
+}
+
+func (d *namespaceDir) Close() error { return nil }
+
+// ReadDir returns the next n entries of the directory, see fs.ReadDirFile.
+func (d *namespaceDir) ReadDir(n int) ([]fs.DirEntry, error) {
+	var entries []fs.DirEntry
+	for n <= 0 || len(entries) < n {
+		if len(d.pending) == 0 {
+			if d.started && d.resume == "" {
+				break
+			}
+			page, err := apiv1.GetIsiDirectoryChildren(d.fs.ctx, d.fs.c.API, d.path, nil, defaultWalkPageSize, d.resume)
+			if err != nil {
+				return entries, err
+			}
+			d.started = true
+			d.pending = page.Children
+			d.resume = page.Resume
+			continue
+		}
+		entries = append(entries, &DirEntry{d.pending[0]})
+		d.pending = d.pending[1:]
+	}
+	if n > 0 && len(entries) == 0 {
+		return nil, io.EOF
+	}
+	return entries, nil
+}
+
+// namespaceFile is an open file of a NamespaceFS. Its content is streamed
+// from the current offset on the first read after opening or seeking.
+type namespaceFile struct {
+	fs     *NamespaceFS
+	path   string
+	info   *DirEntry
+	offset int64
+	r      *FileReader
+}
+
+func (f *namespaceFile) Stat() (fs.FileInfo, error) { return f.info, nil }
+
+func (f *namespaceFile) Read(b []byte) (int, error) {
+	if f.offset >= f.info.Size() {
+		return 0, io.EOF
+	}
+	if f.r == nil {
+		r, err := f.fs.c.OpenFile(f.fs.ctx, f.path, &DownloadOptions{Offset: f.offset})
+		if err != nil {
+			return 0, &fs.PathError{Op: "read", Path: f.path, Err: err}
+		}
+		f.r = r
+	}
+	n, err := f.r.Read(b)
+	f.offset += int64(n)
+	return n, err
+}
+
+func (f *namespaceFile) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekCurrent:
+		offset += f.offset
+	case io.SeekEnd:
+		offset += f.info.Size()
+	}
+	if offset < 0 {
+		return 0, &fs.PathError{Op: "seek", Path: f.path, Err: fs.ErrInvalid}
+	}
+	if offset != f.offset {
+		f.closeReader()
+		f.offset = offset
+	}
+	return offset, nil
+}
+
+// ReadAt reads len(b) bytes at off with a ranged request, independently of the offset of Read.
+func (f *namespaceFile) ReadAt(b []byte, off int64) (int, error) {
+	if off >= f.info.Size() {
+		return 0, io.EOF
+	}
+	length := min(int64(len(b)), f.info.Size()-off)
+	r, err := f.fs.c.OpenFile(f.fs.ctx, f.path, &DownloadOptions{Offset: off, Length: length})
+	if err != nil {
+		return 0, &fs.PathError{Op: "read", Path: f.path, Err: err}
+	}
+	defer r.Close()
+	n, err := io.ReadFull(r, b[:length])
+	if err == nil && n < len(b) {
+		err = io.EOF
+	}
+	return n, err
+}
+
+func (f *namespaceFile) closeReader() {
+	if f.r != nil {
+		f.r.Close()
+		f.r = nil
+	}
+}
+
+func (f *namespaceFile) Close() error {
+	f.closeReader()
+	return nil
+}
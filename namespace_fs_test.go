@@ -0,0 +1,158 @@
+/*
+Copyright (c) 2025 Dell Inc, or its subsidiaries.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package goisilon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"path"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/dell/goisilon/api"
+	apiv1 "github.com/dell/goisilon/api/v1"
+	"github.com/dell/goisilon/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// mockNamespaceFiles serves the metadata of the entries of tree and the
+// content of its files, which is a byte per unit of size.
+func mockNamespaceFiles(c *Client, tree map[string][]apiv1.IsiNamespaceEntry) {
+	lookup := func(id string) (apiv1.IsiNamespaceEntry, bool) {
+		if _, ok := tree[id]; ok {
+			return nsDir(path.Base(id)), true
+		}
+		for _, entry := range tree[path.Dir(id)] {
+			if entry.Name == path.Base(id) {
+				return entry, true
+			}
+		}
+		return apiv1.IsiNamespaceEntry{}, false
+	}
+	c.API.(*mocks.Client).On("Get", mock.Anything, "namespace", mock.Anything, mock.Anything, mock.Anything, mock.AnythingOfType("*v1.GetIsiVolumeAttributesResp")).Return(
+		func(_ context.Context, _, id string, _ api.OrderedValues, _ map[string]string, resp interface{}) error {
+			entry, ok := lookup(id)
+			if !ok {
+				return &api.JSONError{StatusCode: 404}
+			}
+			attrs := resp.(*apiv1.GetIsiVolumeAttributesResp)
+			for name, value := range map[string]interface{}{
+				"type": entry.Type, "size": float64(entry.Size), "mode": entry.Mode, "last_modified": entry.LastModified,
+			} {
+				attrs.AttributeMap = append(attrs.AttributeMap, struct {
+					Name      string      `json:"name"`
+					Value     interface{} `json:"value"`
+					Namespace string      `json:"namespace,omitempty"`
+				}{Name: name, Value: value})
+			}
+			return nil
+		})
+	c.API.(*mocks.Client).On("Get", mock.Anything, "namespace", mock.Anything, mock.Anything, mock.Anything, mock.AnythingOfType("*api.StreamResponse")).Return(
+		func(_ context.Context, _, id string, _ api.OrderedValues, headers map[string]string, resp interface{}) error {
+			entry, ok := lookup(id)
+			if !ok || entry.Type != "object" {
+				return &api.JSONError{StatusCode: 404}
+			}
+			content := strings.Repeat("x", int(entry.Size))
+			start, end := 0, len(content)
+			if r, ok := headers["Range"]; ok {
+				fmt.Sscanf(r, "bytes=%d-%d", &start, &end)
+				end = min(end+1, len(content))
+			}
+			stream := resp.(*api.StreamResponse)
+			stream.OnHeader(http.StatusOK, http.Header{"Content-Length": {fmt.Sprint(end - start)}})
+			_, err := io.WriteString(stream.Body, content[start:end])
+			return err
+		})
+}
+
+func TestNamespaceFS(t *testing.T) {
+	ctx := context.Background()
+	client := &Client{API: new(mocks.Client)}
+	mockNamespaceTree(client, testTree, nil)
+	mockNamespaceFiles(client, testTree)
+
+	fsys := client.FS(ctx, "/ifs/data")
+	assert.NoError(t, fstest.TestFS(fsys, "a/1", "a/2", "a/3", "b/deep/x", "c.txt"))
+
+	data, err := fs.ReadFile(fsys, "b/deep/x")
+	assert.NoError(t, err)
+	assert.Len(t, data, 10)
+
+	matches, err := fs.Glob(fsys, "a/*")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a/1", "a/2", "a/3"}, matches)
+
+	_, err = fsys.Open("missing")
+	assert.True(t, errors.Is(err, fs.ErrNotExist))
+	_, err = fsys.Open("../etc")
+	assert.True(t, errors.Is(err, fs.ErrInvalid))
+
+	f, err := fsys.Open("b/deep/x")
+	assert.NoError(t, err)
+	defer f.Close()
+	_, err = f.(io.Seeker).Seek(-4, io.SeekEnd)
+	assert.NoError(t, err)
+	rest, err := io.ReadAll(f)
+	assert.NoError(t, err)
+	assert.Equal(t, "xxxx", string(rest))
+}
+
+func TestNamespaceFSReadDirFile(t *testing.T) {
+	ctx := context.Background()
+	client := &Client{API: new(mocks.Client)}
+	mockNamespaceTree(client, testTree, map[string]error{"ifs/data/c.txt": errors.New("invalid character 'x' looking for beginning of value")})
+	mockNamespaceFiles(client, testTree)
+
+	_, err := client.FS(ctx, "/ifs/data").ReadDir("c.txt")
+	var pe *fs.PathError
+	assert.ErrorAs(t, err, &pe)
+	assert.Equal(t, "readdir", pe.Op)
+	assert.Equal(t, "c.txt", pe.Path)
+	assert.EqualError(t, pe.Err, "not a directory")
+
+	_, err = client.FS(ctx, "/ifs/data").ReadDir("missing")
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+}
+
+func TestSnapshotFS(t *testing.T) {
+	ctx := context.Background()
+	client := &Client{API: new(mocks.Client)}
+
+	client.API.(*mocks.Client).On("VolumesPath", anyArgs...).Return("/ifs/data")
+	client.API.(*mocks.Client).On("Get", anyArgs[:6]...).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(**apiv1.GetIsiSnapshotsResp)
+		*resp = &apiv1.GetIsiSnapshotsResp{SnapshotList: []*apiv1.IsiSnapshot{{ID: 7, Name: "snap", Path: "/ifs/data/vol"}}, Total: 1}
+	}).Once()
+	client.API.(*mocks.Client).On("Get", anyArgs[:6]...).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*apiv1.GetIsiZonesResp)
+		*resp = apiv1.GetIsiZonesResp{Zones: []*apiv1.IsiZone{{Name: "System", Path: "/ifs/data"}}}
+	}).Once()
+	fsys, err := client.SnapshotFS(ctx, "/ifs/data", "snap", "System")
+	assert.NoError(t, err)
+	assert.Equal(t, "/ifs/data/.snapshot/snap/vol", fsys.Root())
+
+	client.API.(*mocks.Client).On("Get", anyArgs[:6]...).Return(errors.New("snapshot not found")).Once()
+	_, err = client.SnapshotFS(ctx, "/ifs/data", "snap", "System")
+	assert.EqualError(t, err, "snapshot not found")
+}
@@ -0,0 +1,275 @@
+/*
+Copyright (c) 2025 Dell Inc, or its subsidiaries.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package goisilon
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"path"
+	"slices"
+	"time"
+
+	apiv1 "github.com/dell/goisilon/api/v1"
+	apiv2 "github.com/dell/goisilon/api/v2"
+)
+
+// QueryAttr is an attribute of the entries matched by a Query.
+type QueryAttr string
+
+// Attributes of the entries matched by a Query.
+const (
+	QueryAttrName          QueryAttr = "name"
+	QueryAttrContainerPath QueryAttr = "container_path"
+	QueryAttrType          QueryAttr = "type"
+	QueryAttrSize          QueryAttr = "size"
+	QueryAttrLastModified  QueryAttr = "last_modified"
+	QueryAttrOwner         QueryAttr = "owner"
+	QueryAttrGroup         QueryAttr = "group"
+	QueryAttrMode          QueryAttr = "mode"
+)
+
+// QueryOperator compares an attribute with a value in a Query.
+type QueryOperator string
+
+// Operators of the conditions of a Query.
+const (
+	QueryOpEqual        QueryOperator = "="
+	QueryOpNotEqual     QueryOperator = "!="
+	QueryOpLess         QueryOperator = "<"
+	QueryOpLessEqual    QueryOperator = "<="
+	QueryOpGreater      QueryOperator = ">"
+	QueryOpGreaterEqual QueryOperator = ">="
+	// QueryOpLike matches a pattern such as "*.log".
+	QueryOpLike QueryOperator = "like"
+)
+
+// QueryCondition is a condition of a Query, or a group of conditions built
+// with And or Or.
+type QueryCondition interface {
+	condition() interface{}
+}
+
+type queryCondition apiv2.ContainerQueryScopeCondition
+
+func (c queryCondition) condition() interface{} {
+	condition := apiv2.ContainerQueryScopeCondition(c)
+	return &condition
+}
+
+type queryScope struct {
+	logic      string
+	conditions []QueryCondition
+}
+
+func (s queryScope) condition() interface{} {
+	return s.scope()
+}
+
+func (s queryScope) scope() *apiv2.ContainerQueryScope {
+	conditions := make([]interface{}, 0, len(s.conditions))
+	for _, c := range s.conditions {
+		conditions = append(conditions, c.condition())
+	}
+	return &apiv2.ContainerQueryScope{Logic: s.logic, Conditions: conditions}
+}
+
+// Where returns the condition comparing attr with value using op. Times are
+// compared as the cluster formats them.
+func Where(attr QueryAttr, op QueryOperator, value interface{}) QueryCondition {
+	if t, ok := value.(time.Time); ok {
+		value = t.UTC().Format(http.TimeFormat)
+	}
+	return queryCondition{Operator: string(op), Attr: string(attr), Value: value}
+}
+
+// WhereUserMetadata returns the condition comparing the user-defined metadata
+// key with value using op.
+func WhereUserMetadata(key string, op QueryOperator, value interface{}) QueryCondition {
+	return queryCondition{
+		Operator:  string(op),
+		Attr:      key,
+		Value:     value,
+		Namespace: apiv1.IsiUserMetadataNamespace,
+	}
+}
+
+// And returns the condition met when all of conditions are.
+func And(conditions ...QueryCondition) QueryCondition {
+	return queryScope{logic: "and", conditions: conditions}
+}
+
+// Or returns the condition met when any of conditions is.
+func Or(conditions ...QueryCondition) QueryCondition {
+	return queryScope{logic: "or", conditions: conditions}
+}
+
+// IsFile matches regular files.
+func IsFile() QueryCondition { return Where(QueryAttrType, QueryOpEqual, "object") }
+
+// IsDir matches directories.
+func IsDir() QueryCondition {
+	return Where(QueryAttrType, QueryOpEqual, apiv1.IsiNamespaceEntryTypeContainer)
+}
+
+// NameLike matches the entries whose name matches pattern, e.g. "*.log".
+func NameLike(pattern string) QueryCondition { return Where(QueryAttrName, QueryOpLike, pattern) }
+
+// OwnedBy matches the entries owned by the user name.
+func OwnedBy(name string) QueryCondition { return Where(QueryAttrOwner, QueryOpEqual, name) }
+
+// LargerThan matches the entries of more than size bytes.
+func LargerThan(size int64) QueryCondition { return Where(QueryAttrSize, QueryOpGreater, size) }
+
+// SmallerThan matches the entries of less than size bytes.
+func SmallerThan(size int64) QueryCondition { return Where(QueryAttrSize, QueryOpLess, size) }
+
+// ModifiedBefore matches the entries last modified before t.
+func ModifiedBefore(t time.Time) QueryCondition {
+	return Where(QueryAttrLastModified, QueryOpLess, t)
+}
+
+// ModifiedAfter matches the entries last modified after t.
+func ModifiedAfter(t time.Time) QueryCondition {
+	return Where(QueryAttrLastModified, QueryOpGreater, t)
+}
+
+// Query finds the entries below a directory that match its conditions, e.g.
+//
+//	q := c.NewQuery(isiPath).Where(
+//		IsFile(),
+//		LargerThan(10<<30),
+//		ModifiedBefore(time.Now().AddDate(0, 0, -180)))
+//	for e, err := range q.Entries(ctx) { ... }
+type Query struct {
+	c          *Client
+	root       string
+	conditions []QueryCondition
+	result     []string
+	maxDepth   int
+	pageSize   int
+}
+
+// NewQuery returns a query of the entries below the directory at the absolute
+// path root, at any depth, that matches all entries until conditions are added.
+func (c *Client) NewQuery(root string) *Query {
+	return &Query{c: c, root: path.Clean(root), maxDepth: -1, pageSize: defaultWalkPageSize}
+}
+
+// Where adds conditions which all must be met.
+func (q *Query) Where(conditions ...QueryCondition) *Query {
+	q.conditions = append(q.conditions, conditions...)
+	return q
+}
+
+// Select adds attributes to return for every entry. The name, container path
+// and type are always returned.
+func (q *Query) Select(attrs ...QueryAttr) *Query {
+	for _, attr := range attrs {
+		q.result = append(q.result, string(attr))
+	}
+	return q
+}
+
+// MaxDepth limits how deep below the root entries are matched, 1 matches only
+// the children of the root. 0 or less means no limit.
+func (q *Query) MaxDepth(depth int) *Query {
+	if depth <= 0 {
+		depth = -1
+	}
+	q.maxDepth = depth
+	return q
+}
+
+// PageSize sets the number of entries requested at once, it defaults to 1000.
+func (q *Query) PageSize(size int) *Query {
+	if size > 0 {
+		q.pageSize = size
+	}
+	return q
+}
+
+// Build returns the namespace API query of q.
+func (q *Query) Build() *apiv2.ContainerQuery {
+	result := []string{string(QueryAttrName), string(QueryAttrContainerPath), string(QueryAttrType)}
+	for _, attr := range q.result {
+		if !slices.Contains(result, attr) {
+			result = append(result, attr)
+		}
+	}
+	query := &apiv2.ContainerQuery{Result: result}
+	if len(q.conditions) > 0 {
+		query.Scope = queryScope{logic: "and", conditions: q.conditions}.scope()
+	}
+	return query
+}
+
+// Entries returns an iterator over the matching entries, requesting them one
+// page at a time. Iteration stops after the first error.
+func (q *Query) Entries(ctx context.Context) iter.Seq2[WalkEntry, error] {
+	return func(yield func(WalkEntry, error) bool) {
+		query := q.Build()
+		resume := ""
+		for {
+			children, next, err := apiv2.ContainerChildrenPostQueryWithIsiPath(
+				ctx, q.c.API, q.root, q.pageSize, q.maxDepth, query, resume)
+			if err != nil {
+				yield(WalkEntry{}, err)
+				return
+			}
+			for _, child := range children {
+				d := &DirEntry{namespaceEntry(child)}
+				if !yield(WalkEntry{Path: path.Join(d.ContainerPath, d.Name()), Entry: d}, nil) {
+					return
+				}
+			}
+			if next == "" {
+				return
+			}
+			resume = next
+		}
+	}
+}
+
+// namespaceEntry returns the attributes of a queried child set in it.
+func namespaceEntry(child *apiv2.ContainerChild) apiv1.IsiNamespaceEntry {
+	var e apiv1.IsiNamespaceEntry
+	if child.Name != nil {
+		e.Name = *child.Name
+	}
+	if child.Path != nil {
+		e.ContainerPath = *child.Path
+	}
+	if child.Type != nil {
+		e.Type = *child.Type
+	}
+	if child.Size != nil {
+		e.Size = int64(*child.Size)
+	}
+	if child.Mode != nil {
+		e.Mode = child.Mode.String()
+	}
+	if child.Owner != nil {
+		e.Owner = *child.Owner
+	}
+	if child.Group != nil {
+		e.Group = *child.Group
+	}
+	if child.LastModified != nil {
+		e.LastModified = *child.LastModified
+	}
+	return e
+}
@@ -0,0 +1,90 @@
+/*
+Copyright (c) 2025 Dell Inc, or its subsidiaries.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package goisilon
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"testing"
+	"time"
+
+	"github.com/dell/goisilon/api"
+	"github.com/dell/goisilon/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestQueryBuild(t *testing.T) {
+	client := &Client{API: new(mocks.Client)}
+	cutoff := time.Date(2025, 1, 2, 3, 4, 5, 0, time.FixedZone("CET", 3600))
+
+	q := client.NewQuery("/ifs/volumes/vol/").
+		Where(IsFile(), LargerThan(10<<30), ModifiedBefore(cutoff)).
+		Where(Or(NameLike("*.log"), WhereUserMetadata("tier", QueryOpEqual, "gold"))).
+		Select(QueryAttrSize, QueryAttrName, QueryAttrLastModified)
+
+	data, err := json.Marshal(q.Build())
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"result":["name","container_path","type","size","last_modified"],
+		"scope":{"logic":"and","conditions":[
+			{"operator":"=","attr":"type","value":"object"},
+			{"operator":">","attr":"size","value":10737418240},
+			{"operator":"<","attr":"last_modified","value":"Thu, 02 Jan 2025 02:04:05 GMT"},
+			{"logic":"or","conditions":[
+				{"operator":"like","attr":"name","value":"*.log"},
+				{"operator":"=","attr":"tier","value":"gold","namespace":"user"}]}]}}`, string(data))
+
+	assert.Nil(t, client.NewQuery("/ifs").Build().Scope)
+}
+
+func TestQueryEntries(t *testing.T) {
+	ctx := context.Background()
+	client := &Client{API: new(mocks.Client)}
+
+	pages := []string{
+		`{"children":[{"name":"a.log","container_path":"/ifs/data","type":"object","size":11811160064,"mode":"0640"}],"resume":"next"}`,
+		`{"children":[{"name":"b.log","container_path":"/ifs/data/sub","type":"object"}]}`,
+	}
+	var queries []string
+	for _, page := range pages {
+		client.API.(*mocks.Client).On("Post", ctx, "namespace", "ifs/data", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			params := args.Get(3).(api.OrderedValues)
+			queries = append(queries, params.Encode())
+			assert.NoError(t, json.Unmarshal([]byte(page), args.Get(6)))
+		}).Once()
+	}
+
+	var entries []WalkEntry
+	var paths []string
+	for e, err := range client.NewQuery("/ifs/data").Where(NameLike("*.log")).MaxDepth(2).PageSize(1).Entries(ctx) {
+		assert.NoError(t, err)
+		entries = append(entries, e)
+		paths = append(paths, e.Path)
+	}
+	assert.Equal(t, []string{"/ifs/data/a.log", "/ifs/data/sub/b.log"}, paths)
+	assert.Equal(t, int64(11<<30), entries[0].Entry.Size())
+	assert.Equal(t, fs.FileMode(0o640), entries[0].Entry.Mode())
+	assert.Equal(t, []string{"query&limit=1&max-depth=2", "query&limit=1&max-depth=2&resume=next"}, queries)
+
+	client.API.(*mocks.Client).On("Post", ctx, "namespace", "ifs/data", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("query failed")).Once()
+	for _, err := range client.NewQuery("/ifs/data").Entries(ctx) {
+		assert.EqualError(t, err, "query failed")
+	}
+}
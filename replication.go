@@ -18,8 +18,10 @@ limitations under the License.
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -29,8 +31,9 @@ import (
 )
 
 const (
-	defaultPoll    = 5 * time.Second
-	defaultTimeout = 10 * time.Minute // set high timeout, we expect to be canceled via context before
+	defaultPoll           = 5 * time.Second
+	defaultTimeout        = 10 * time.Minute // set high timeout, we expect to be canceled via context before
+	defaultSyncStartDelay = 3 * time.Second
 )
 
 const (
@@ -81,6 +84,21 @@ func (c *Client) CreatePolicy(ctx context.Context, name string, rpo int, sourceP
 	return apiv11.CreatePolicy(ctx, c.API, name, sourcePath, targetPath, targetHost, targetCert, rpo, enabled)
 }
 
+// CreatePolicyWithParams creates a policy from the full SyncIQ policy model and returns its ID.
+func (c *Client) CreatePolicyWithParams(ctx context.Context, policy *apiv11.Policy) (string, error) {
+	return apiv11.CreatePolicyWithParams(ctx, c.API, policy)
+}
+
+// GetPolicies returns all SyncIQ policies on the cluster.
+func (c *Client) GetPolicies(ctx context.Context) ([]apiv11.Policy, error) {
+	return apiv11.GetPolicies(ctx, c.API)
+}
+
+// UpdatePolicyWithParams modifies the fields of the named policy that are set in update.
+func (c *Client) UpdatePolicyWithParams(ctx context.Context, name string, update *apiv11.PolicyUpdate) error {
+	return apiv11.UpdatePolicyWithParams(ctx, c.API, name, update)
+}
+
 func (c *Client) DeletePolicy(ctx context.Context, name string) error {
 	return apiv11.DeletePolicy(ctx, c.API, name)
 }
@@ -174,6 +192,12 @@ func (c *Client) ResolvePolicy(ctx context.Context, name string) error {
 }
 
 func (c *Client) AllowWrites(ctx context.Context, policyName string) error {
+	return c.AllowWritesWithOptions(ctx, policyName, DefaultWaitOptions())
+}
+
+// AllowWritesWithOptions allows writes to the target directory of the policy and waits
+// for the target policy to report it, polling as set in opts.
+func (c *Client) AllowWritesWithOptions(ctx context.Context, policyName string, opts WaitOptions) error {
 	targetPolicy, err := c.GetTargetPolicyByName(ctx, policyName)
 	if err != nil {
 		return err
@@ -187,7 +211,7 @@ func (c *Client) AllowWrites(ctx context.Context, policyName string) error {
 		return err
 	}
 
-	err = c.WaitForTargetPolicyCondition(ctx, policyName, WritesEnabled)
+	err = c.WaitForTargetPolicyConditionWithOptions(ctx, policyName, WritesEnabled, opts)
 	if err != nil {
 		return err
 	}
@@ -196,6 +220,12 @@ func (c *Client) AllowWrites(ctx context.Context, policyName string) error {
 }
 
 func (c *Client) DisallowWrites(ctx context.Context, policyName string) error {
+	return c.DisallowWritesWithOptions(ctx, policyName, DefaultWaitOptions())
+}
+
+// DisallowWritesWithOptions disallows writes to the target directory of the policy and waits
+// for the target policy to report it, polling as set in opts.
+func (c *Client) DisallowWritesWithOptions(ctx context.Context, policyName string, opts WaitOptions) error {
 	targetPolicy, err := c.GetTargetPolicyByName(ctx, policyName)
 	if err != nil {
 		return err
@@ -209,7 +239,7 @@ func (c *Client) DisallowWrites(ctx context.Context, policyName string) error {
 		return err
 	}
 
-	err = c.WaitForTargetPolicyCondition(ctx, policyName, WritesDisabled)
+	err = c.WaitForTargetPolicyConditionWithOptions(ctx, policyName, WritesDisabled, opts)
 	if err != nil {
 		return err
 	}
@@ -239,6 +269,72 @@ func (c *Client) StartSyncIQJob(ctx context.Context, job *apiv11.JobRequest) (*a
 	return apiv11.StartSyncIQJob(ctx, c.API, job)
 }
 
+// GetSyncIQJob returns the running job of a policy, including its progress.
+func (c *Client) GetSyncIQJob(ctx context.Context, policyName string) (*apiv11.Job, error) {
+	return apiv11.GetSyncIQJob(ctx, c.API, policyName)
+}
+
+// PauseSyncIQJob pauses the running job of a policy.
+func (c *Client) PauseSyncIQJob(ctx context.Context, policyName string) error {
+	return apiv11.UpdateSyncIQJobState(ctx, c.API, policyName, PAUSED)
+}
+
+// ResumeSyncIQJob resumes the paused job of a policy.
+func (c *Client) ResumeSyncIQJob(ctx context.Context, policyName string) error {
+	return apiv11.UpdateSyncIQJobState(ctx, c.API, policyName, RUNNING)
+}
+
+// CancelSyncIQJob cancels the running job of a policy.
+func (c *Client) CancelSyncIQJob(ctx context.Context, policyName string) error {
+	return apiv11.UpdateSyncIQJobState(ctx, c.API, policyName, CANCELED)
+}
+
+// GetSyncRules returns the SyncIQ performance rules of the given type, or of all types if ruleType is empty.
+func (c *Client) GetSyncRules(ctx context.Context, ruleType apiv11.SyncRuleType) ([]apiv11.SyncRule, error) {
+	return apiv11.GetSyncRules(ctx, c.API, ruleType)
+}
+
+// GetSyncRule returns a SyncIQ performance rule by ID.
+func (c *Client) GetSyncRule(ctx context.Context, id string) (*apiv11.SyncRule, error) {
+	return apiv11.GetSyncRule(ctx, c.API, id)
+}
+
+// CreateSyncRule creates a SyncIQ performance rule and returns its ID.
+func (c *Client) CreateSyncRule(ctx context.Context, rule *apiv11.SyncRule) (string, error) {
+	return apiv11.CreateSyncRule(ctx, c.API, rule)
+}
+
+// CreateBandwidthRule limits SyncIQ to limitKbps between begin and end, given
+// as "HH:MM", on the given days and returns the ID of the rule.
+func (c *Client) CreateBandwidthRule(ctx context.Context, limitKbps int, begin, end string, days ...time.Weekday) (string, error) {
+	return apiv11.CreateSyncRule(ctx, c.API, &apiv11.SyncRule{
+		Type:     apiv11.BandwidthRule,
+		Limit:    limitKbps,
+		Enabled:  true,
+		Schedule: apiv11.NewSyncRuleSchedule(begin, end, days...),
+	})
+}
+
+// UpdateSyncRule modifies the fields of a SyncIQ performance rule that are set in update.
+func (c *Client) UpdateSyncRule(ctx context.Context, id string, update *apiv11.SyncRuleUpdate) error {
+	return apiv11.UpdateSyncRule(ctx, c.API, id, update)
+}
+
+// DeleteSyncRule deletes a SyncIQ performance rule.
+func (c *Client) DeleteSyncRule(ctx context.Context, id string) error {
+	return apiv11.DeleteSyncRule(ctx, c.API, id)
+}
+
+// GetSyncSettings returns the global SyncIQ settings.
+func (c *Client) GetSyncSettings(ctx context.Context) (*apiv11.SyncSettings, error) {
+	return apiv11.GetSyncSettings(ctx, c.API)
+}
+
+// UpdateSyncSettings modifies the global SyncIQ settings that are set in update.
+func (c *Client) UpdateSyncSettings(ctx context.Context, update *apiv11.SyncSettingsUpdate) error {
+	return apiv11.UpdateSyncSettings(ctx, c.API, update)
+}
+
 func (c *Client) GetReport(ctx context.Context, reportName string) (*apiv11.Report, error) {
 	return apiv11.GetReport(ctx, c.API, reportName)
 }
@@ -247,48 +343,115 @@ func (c *Client) GetReportsByPolicyName(ctx context.Context, policyName string,
 	return apiv11.GetReportsByPolicyName(ctx, c.API, policyName, reportsForPolicy)
 }
 
-func (c *Client) WaitForPolicyEnabledFieldCondition(ctx context.Context, policyName string, enabled bool) error {
-	pollErr := poll.ImmediateWithContext(ctx, defaultPoll, defaultTimeout,
-		func(iCtx context.Context) (bool, error) {
-			p, err := c.GetPolicyByName(iCtx, policyName)
-			if err != nil {
-				return false, err
-			}
+// WaitOptions configures how the replication waiters poll the cluster.
+type WaitOptions struct {
+	// Interval between checks, it defaults to 5 seconds.
+	Interval time.Duration
+	// Timeout bounds the whole wait, 0 or less means no bound other than the
+	// context. DefaultWaitOptions sets it to 10 minutes.
+	Timeout time.Duration
+	// Backoff multiplies the interval after every unsuccessful check. Values
+	// up to 1 keep the interval constant.
+	Backoff float64
+	// MaxInterval caps the interval when backing off, 0 means no cap.
+	MaxInterval time.Duration
+	// Jitter adds a random delay of up to Jitter times the interval.
+	Jitter float64
+	// OnProgress is called after every check that did not meet the condition.
+	OnProgress func(WaitProgress)
+}
 
-			if p.Enabled != enabled {
-				return false, nil
-			}
+// WaitProgress describes an unsuccessful check of a replication waiter.
+type WaitProgress struct {
+	Attempt int
+	Elapsed time.Duration
+	// State is the observed value the waiter compared, e.g. the job state.
+	State string
+}
 
-			return true, nil
-		})
+// DefaultWaitOptions polls every 5 seconds for up to 10 minutes.
+func DefaultWaitOptions() WaitOptions {
+	return WaitOptions{Interval: defaultPoll, Timeout: defaultTimeout}
+}
 
-	if pollErr != nil {
-		return pollErr
+func (o WaitOptions) withDefaults() WaitOptions {
+	if o.Interval <= 0 {
+		o.Interval = defaultPoll
 	}
+	return o
+}
 
-	return nil
+// waitFor checks condition immediately and then as set in opts until it
+// returns true or an error. If it times out or ctx is canceled first, the
+// returned error wraps poll.ErrWaitTimeout or poll.ErrWaitCanceled and
+// includes the last observed state.
+func waitFor(ctx context.Context, opts WaitOptions, condition func(context.Context) (bool, string, error)) error {
+	opts = opts.withDefaults()
+	pollOpts := poll.Options[string]{
+		Backoff: poll.Backoff{
+			Interval: opts.Interval,
+			Factor:   opts.Backoff,
+			Jitter:   opts.Jitter,
+			Cap:      opts.MaxInterval,
+		},
+		Timeout: opts.Timeout,
+	}
+	if opts.OnProgress != nil {
+		pollOpts.OnProgress = func(p poll.Progress[string]) {
+			opts.OnProgress(WaitProgress{Attempt: p.Attempt, Elapsed: p.Elapsed, State: p.Value})
+		}
+	}
+	state, err := poll.PollUntil(ctx, pollOpts, func(iCtx context.Context) (string, bool, error) {
+		done, state, err := condition(iCtx)
+		return state, done, err
+	})
+	if state != "" && (errors.Is(err, poll.ErrWaitTimeout) || errors.Is(err, poll.ErrWaitCanceled)) {
+		return fmt.Errorf("%w, last observed state %s", err, state)
+	}
+	return err
 }
 
-func (c *Client) WaitForNoActiveJobs(ctx context.Context, policyName string) error {
-	pollErr := poll.ImmediateWithContext(ctx, defaultPoll, defaultTimeout,
-		func(iCtx context.Context) (bool, error) {
-			p, err := c.GetJobsByPolicyName(iCtx, policyName)
-			if err != nil {
-				return false, err
-			}
+// sleepWithContext waits for d and returns ctx.Err() if ctx is done first.
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
 
-			if len(p) != 0 {
-				return false, nil
-			}
+func (c *Client) WaitForPolicyEnabledFieldCondition(ctx context.Context, policyName string, enabled bool) error {
+	return c.WaitForPolicyEnabledFieldConditionWithOptions(ctx, policyName, enabled, DefaultWaitOptions())
+}
 
-			return true, nil
-		})
+// WaitForPolicyEnabledFieldConditionWithOptions waits until the policy is enabled or disabled, polling as set in opts.
+func (c *Client) WaitForPolicyEnabledFieldConditionWithOptions(ctx context.Context, policyName string, enabled bool, opts WaitOptions) error {
+	return waitFor(ctx, opts, func(iCtx context.Context) (bool, string, error) {
+		p, err := c.GetPolicyByName(iCtx, policyName)
+		if err != nil {
+			return false, "", err
+		}
+		return p.Enabled == enabled, strconv.FormatBool(p.Enabled), nil
+	})
+}
 
-	if pollErr != nil {
-		return pollErr
-	}
+func (c *Client) WaitForNoActiveJobs(ctx context.Context, policyName string) error {
+	return c.WaitForNoActiveJobsWithOptions(ctx, policyName, DefaultWaitOptions())
+}
 
-	return nil
+// WaitForNoActiveJobsWithOptions waits until the policy has no running jobs, polling as set in opts.
+// The observed state is the number of jobs.
+func (c *Client) WaitForNoActiveJobsWithOptions(ctx context.Context, policyName string, opts WaitOptions) error {
+	return waitFor(ctx, opts, func(iCtx context.Context) (bool, string, error) {
+		p, err := c.GetJobsByPolicyName(iCtx, policyName)
+		if err != nil {
+			return false, "", err
+		}
+		return len(p) == 0, strconv.Itoa(len(p)), nil
+	})
 }
 
 // WaitForPolicyLastJobState queries the PowerScale system for the given policyName and waits for the LastJobState to
@@ -296,49 +459,79 @@ func (c *Client) WaitForNoActiveJobs(ctx context.Context, policyName string) err
 //
 // The poll interval is 5 seconds and the timeout is 10 minutes.
 func (c *Client) WaitForPolicyLastJobState(ctx context.Context, policyName string, state ...apiv11.JobState) error {
-	pollErr := poll.ImmediateWithContext(ctx, defaultPoll, defaultTimeout,
-		func(iCtx context.Context) (bool, error) {
-			p, err := c.GetPolicyByName(iCtx, policyName)
-			if err != nil {
-				return false, err
-			}
-
-			return slices.Contains(state, p.LastJobState), nil
-		})
-
-	if pollErr != nil {
-		return pollErr
-	}
+	return c.WaitForPolicyLastJobStateWithOptions(ctx, policyName, DefaultWaitOptions(), state...)
+}
 
-	return nil
+// WaitForPolicyLastJobStateWithOptions is WaitForPolicyLastJobState polling as set in opts.
+func (c *Client) WaitForPolicyLastJobStateWithOptions(ctx context.Context, policyName string, opts WaitOptions, state ...apiv11.JobState) error {
+	return waitFor(ctx, opts, func(iCtx context.Context) (bool, string, error) {
+		p, err := c.GetPolicyByName(iCtx, policyName)
+		if err != nil {
+			return false, "", err
+		}
+		return slices.Contains(state, p.LastJobState), string(p.LastJobState), nil
+	})
 }
 
 func (c *Client) WaitForTargetPolicyCondition(ctx context.Context, policyName string, condition apiv11.FailoverFailbackState) error {
-	pollErr := poll.ImmediateWithContext(ctx, defaultPoll, defaultTimeout,
-		func(iCtx context.Context) (bool, error) {
-			tp, err := c.GetTargetPolicyByName(iCtx, policyName)
-			if err != nil {
-				return false, err
-			}
+	return c.WaitForTargetPolicyConditionWithOptions(ctx, policyName, condition, DefaultWaitOptions())
+}
 
-			if tp.FailoverFailbackState != condition {
-				return false, nil
-			}
+// WaitForTargetPolicyConditionWithOptions waits until the target policy reaches the failover/failback state
+// condition, polling as set in opts.
+func (c *Client) WaitForTargetPolicyConditionWithOptions(
+	ctx context.Context, policyName string, condition apiv11.FailoverFailbackState, opts WaitOptions,
+) error {
+	return waitFor(ctx, opts, func(iCtx context.Context) (bool, string, error) {
+		tp, err := c.GetTargetPolicyByName(iCtx, policyName)
+		if err != nil {
+			return false, "", err
+		}
+		return tp.FailoverFailbackState == condition, string(tp.FailoverFailbackState), nil
+	})
+}
 
-			return true, nil
-		})
+// SyncOptions configures SyncPolicyWithOptions.
+type SyncOptions struct {
+	// Wait configures waiting for the sync job to complete.
+	Wait WaitOptions
+	// MaxRetries is how often a job failing with a retryable error is started, it defaults to 20.
+	MaxRetries int
+	// RetryInterval is the time between retries, it defaults to 15 seconds.
+	RetryInterval time.Duration
+	// StartDelay is the time given to a started job to show up, it defaults to 3 seconds.
+	StartDelay time.Duration
+}
 
-	if pollErr != nil {
-		return pollErr
+// DefaultSyncOptions are the options used by SyncPolicy.
+func DefaultSyncOptions() SyncOptions {
+	return SyncOptions{
+		Wait:          DefaultWaitOptions(),
+		MaxRetries:    maxRetries,
+		RetryInterval: retryInterval,
+		StartDelay:    defaultSyncStartDelay,
 	}
-
-	return nil
 }
 
 func (c *Client) SyncPolicy(ctx context.Context, policyName string) error {
+	return c.SyncPolicyWithOptions(ctx, policyName, DefaultSyncOptions())
+}
+
+// SyncPolicyWithOptions runs a sync job of the policy, or waits for the running one, and waits for it to complete.
+// It returns ctx.Err() if ctx is done while waiting to retry.
+func (c *Client) SyncPolicyWithOptions(ctx context.Context, policyName string, opts SyncOptions) error {
 	// get all running
 	// if running - wait for it and succeed
 	// if no running - start new - wait for it and succeed
+	if opts.MaxRetries <= 0 {
+		opts.MaxRetries = maxRetries
+	}
+	if opts.RetryInterval <= 0 {
+		opts.RetryInterval = retryInterval
+	}
+	if opts.StartDelay <= 0 {
+		opts.StartDelay = defaultSyncStartDelay
+	}
 
 	var isRunning bool
 
@@ -363,7 +556,7 @@ func (c *Client) SyncPolicy(ctx context.Context, policyName string) error {
 	}
 	if isRunning {
 		log.Info(ctx, "found active jobs, waiting for completion")
-		err = c.WaitForNoActiveJobs(ctx, policyName)
+		err = c.WaitForNoActiveJobsWithOptions(ctx, policyName, opts.Wait)
 		if err != nil {
 			return err
 		}
@@ -376,13 +569,13 @@ func (c *Client) SyncPolicy(ctx context.Context, policyName string) error {
 
 	// workaround for PowerScale KB article
 	// https://www.dell.com/support/kbdoc/en-us/000019414/quotas-on-synciq-source-directories
-	for i := 0; i < maxRetries; i++ {
+	for i := 0; i < opts.MaxRetries; i++ {
 		_, err := c.StartSyncIQJob(ctx, jobReq)
 		if err == nil {
 			break
 		}
 		if strings.Contains(err.Error(), retryablePolicyError) {
-			if i+1 == maxRetries {
+			if i+1 == opts.MaxRetries {
 				return err
 			}
 
@@ -396,8 +589,10 @@ func (c *Client) SyncPolicy(ctx context.Context, policyName string) error {
 			}
 
 			log.Info(ctx, "Sync job failed with error: %s. %v of %v - retrying in %v...",
-				reports.Reports[0].Errors[0], i+1, maxRetries, retryInterval)
-			time.Sleep(retryInterval)
+				reports.Reports[0].Errors[0], i+1, opts.MaxRetries, opts.RetryInterval)
+			if err := sleepWithContext(ctx, opts.RetryInterval); err != nil {
+				return err
+			}
 
 			// Resolve policy with error before retrying
 			err = c.ResolvePolicy(ctx, policyName)
@@ -409,8 +604,10 @@ func (c *Client) SyncPolicy(ctx context.Context, policyName string) error {
 		}
 	}
 
-	time.Sleep(3 * time.Second)
-	err = c.WaitForNoActiveJobs(ctx, policyName)
+	if err := sleepWithContext(ctx, opts.StartDelay); err != nil {
+		return err
+	}
+	err = c.WaitForNoActiveJobsWithOptions(ctx, policyName, opts.Wait)
 	if err != nil {
 		return err
 	}
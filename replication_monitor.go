@@ -0,0 +1,234 @@
+/*
+Copyright (c) 2025 Dell Inc, or its subsidiaries.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package goisilon
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	log "github.com/akutz/gournal"
+	apiv11 "github.com/dell/goisilon/api/v11"
+)
+
+// ReplicationEventType is the kind of a ReplicationEvent.
+type ReplicationEventType string
+
+const (
+	// EventJobFailed is emitted when the last job of a policy failed.
+	EventJobFailed ReplicationEventType = "job_failed"
+	// EventNeedsAttention is emitted when the last job of a policy needs attention.
+	EventNeedsAttention ReplicationEventType = "needs_attention"
+	// EventPolicyConflicted is emitted when a policy becomes conflicted.
+	EventPolicyConflicted ReplicationEventType = "policy_conflicted"
+	// EventRPOBreached is emitted when the time since the last successful job
+	// of a policy exceeds its RPO alert, or its job delay if no alert is set.
+	// A policy that never succeeded is measured from when it was first polled.
+	EventRPOBreached ReplicationEventType = "rpo_breached"
+	// EventPolicyDisabled is emitted when a policy is disabled.
+	EventPolicyDisabled ReplicationEventType = "policy_disabled"
+	// EventTargetWritesEnabled is emitted when writes are allowed to the
+	// target directory of a policy, that is when it was failed over.
+	EventTargetWritesEnabled ReplicationEventType = "target_writes_enabled"
+)
+
+// ReplicationEvent reports a change in the health of a SyncIQ policy.
+type ReplicationEvent struct {
+	Type       ReplicationEventType
+	PolicyName string
+	Time       time.Time
+	Policy     apiv11.Policy
+	// Lag is the time since the last successful job, set for EventRPOBreached.
+	Lag     time.Duration
+	Message string
+}
+
+// policyHealth is the set of conditions that are currently true for a policy.
+type policyHealth map[ReplicationEventType]bool
+
+// ReplicationMonitor polls the SyncIQ policies of a cluster and notifies its
+// subscribers when a policy enters an unhealthy state. Events are emitted
+// once when a condition starts to hold, and again only after it cleared.
+type ReplicationMonitor struct {
+	// Source is the cluster whose policies are watched.
+	Source *Client
+	// Target is the cluster the policies replicate to. If set, the target
+	// policies are checked for writes being enabled.
+	Target *Client
+	// Interval between polls, it defaults to 5 seconds.
+	Interval time.Duration
+
+	mu          sync.Mutex
+	nextID      int
+	subscribers map[int]func(ReplicationEvent)
+	health      map[string]policyHealth
+	// firstSeen is when a policy that never succeeded was first polled
+	firstSeen map[string]time.Time
+	now       func() time.Time
+}
+
+// NewReplicationMonitor returns a monitor of the policies of source polling every interval.
+func NewReplicationMonitor(source, target *Client, interval time.Duration) *ReplicationMonitor {
+	return &ReplicationMonitor{Source: source, Target: target, Interval: interval}
+}
+
+// Subscribe registers fn to be called with every event and returns a function
+// that unregisters it. Subscribers are called from the polling goroutine one
+// after another and should not block.
+func (m *ReplicationMonitor) Subscribe(fn func(ReplicationEvent)) func() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.subscribers == nil {
+		m.subscribers = make(map[int]func(ReplicationEvent))
+	}
+	id := m.nextID
+	m.nextID++
+	m.subscribers[id] = fn
+	return func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		delete(m.subscribers, id)
+	}
+}
+
+func (m *ReplicationMonitor) emit(event ReplicationEvent) {
+	m.mu.Lock()
+	subscribers := make([]func(ReplicationEvent), 0, len(m.subscribers))
+	for _, fn := range m.subscribers {
+		subscribers = append(subscribers, fn)
+	}
+	m.mu.Unlock()
+	for _, fn := range subscribers {
+		fn(event)
+	}
+}
+
+// check returns the events for the conditions that hold for a policy.
+// previous is the health of the policy at the previous poll and since when the
+// policy is known to have never succeeded, if it has not.
+func (m *ReplicationMonitor) check(
+	ctx context.Context, policy apiv11.Policy, now time.Time, previous policyHealth, since time.Time,
+) []ReplicationEvent {
+	var events []ReplicationEvent
+	add := func(t ReplicationEventType, lag time.Duration, format string, args ...interface{}) {
+		events = append(events, ReplicationEvent{
+			Type: t, PolicyName: policy.Name, Time: now, Policy: policy, Lag: lag,
+			Message: fmt.Sprintf(format, args...),
+		})
+	}
+
+	switch policy.LastJobState {
+	case FAILED:
+		add(EventJobFailed, 0, "last job of policy %s failed", policy.Name)
+	case NeedsAttention:
+		add(EventNeedsAttention, 0, "last job of policy %s needs attention", policy.Name)
+	}
+	if policy.Conflicted {
+		add(EventPolicyConflicted, 0, "policy %s is conflicted", policy.Name)
+	}
+	if !policy.Enabled {
+		add(EventPolicyDisabled, 0, "policy %s is disabled", policy.Name)
+	} else if rpo := rpoThreshold(policy); rpo > 0 {
+		if policy.LastSuccess > 0 {
+			if lag := now.Sub(time.Unix(policy.LastSuccess, 0)); lag > rpo {
+				add(EventRPOBreached, lag, "policy %s last succeeded %s ago, exceeding its RPO of %s", policy.Name, lag.Round(time.Second), rpo)
+			}
+		} else if lag := now.Sub(since); lag > rpo {
+			add(EventRPOBreached, lag, "policy %s has not succeeded in %s, exceeding its RPO of %s", policy.Name, lag.Round(time.Second), rpo)
+		}
+	}
+	if m.Target != nil {
+		tp, err := m.Target.GetTargetPolicyByName(ctx, policy.Name)
+		if err != nil {
+			log.Warn(ctx, "failed to get target policy %s: %v", policy.Name, err)
+			// the state is unknown, keep the previous one rather than clearing it
+			if previous[EventTargetWritesEnabled] {
+				add(EventTargetWritesEnabled, 0, "writes are enabled on the target of policy %s", policy.Name)
+			}
+		} else if tp != nil && tp.FailoverFailbackState == WritesEnabled {
+			add(EventTargetWritesEnabled, 0, "writes are enabled on the target of policy %s", policy.Name)
+		}
+	}
+	return events
+}
+
+// Poll checks every policy once and notifies the subscribers of conditions
+// that started to hold since the previous poll.
+func (m *ReplicationMonitor) Poll(ctx context.Context) error {
+	policies, err := m.Source.GetPolicies(ctx)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	if m.now != nil {
+		now = m.now()
+	}
+
+	current := make(map[string]policyHealth, len(policies))
+	firstSeen := make(map[string]time.Time)
+	var events []ReplicationEvent
+	m.mu.Lock()
+	previous, previousSeen := m.health, m.firstSeen
+	m.mu.Unlock()
+	for _, policy := range policies {
+		// a policy that never succeeded is measured from when it was first polled
+		since := now
+		if policy.LastSuccess == 0 {
+			if seen, ok := previousSeen[policy.Name]; ok {
+				since = seen
+			}
+			firstSeen[policy.Name] = since
+		}
+		health := policyHealth{}
+		for _, event := range m.check(ctx, policy, now, previous[policy.Name], since) {
+			health[event.Type] = true
+			if !previous[policy.Name][event.Type] {
+				events = append(events, event)
+			}
+		}
+		current[policy.Name] = health
+	}
+	m.mu.Lock()
+	m.health, m.firstSeen = current, firstSeen
+	m.mu.Unlock()
+
+	for _, event := range events {
+		m.emit(event)
+	}
+	return nil
+}
+
+// Run polls the policies every Interval until ctx is done and returns ctx.Err().
+// Polling errors are logged and do not stop the monitor.
+func (m *ReplicationMonitor) Run(ctx context.Context) error {
+	interval := m.Interval
+	if interval <= 0 {
+		interval = defaultPoll
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := m.Poll(ctx); err != nil {
+			log.Error(ctx, "failed to poll replication policies: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,137 @@
+/*
+Copyright (c) 2025 Dell Inc, or its subsidiaries.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package goisilon
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	apiv11 "github.com/dell/goisilon/api/v11"
+	"github.com/dell/goisilon/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func mockPolicies(c *mocks.Client, policies ...apiv11.Policy) *mock.Call {
+	return c.On("Get", mock.Anything, "/platform/11/sync/policies/", "", mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(**apiv11.Policies)
+		*resp = &apiv11.Policies{Policy: policies}
+	})
+}
+
+func TestReplicationMonitorPoll(t *testing.T) {
+	ctx := context.Background()
+	source := &Client{API: new(mocks.Client)}
+	target := &Client{API: new(mocks.Client)}
+	now := time.Unix(1700000000, 0)
+	m := NewReplicationMonitor(source, target, time.Second)
+	m.now = func() time.Time { return now }
+
+	var events []ReplicationEvent
+	unsubscribe := m.Subscribe(func(e ReplicationEvent) { events = append(events, e) })
+
+	failed := apiv11.Policy{Name: "failed", Enabled: true, LastJobState: FAILED}
+	lagging := apiv11.Policy{Name: "lagging", Enabled: true, Schedule: "when-source-modified", JobDelay: 3600, LastSuccess: now.Add(-2 * time.Hour).Unix()}
+	disabled := apiv11.Policy{Name: "disabled", Enabled: false, LastJobState: FINISHED}
+	mockPolicies(source.API.(*mocks.Client), failed, lagging, disabled).Twice()
+	mockTargetPolicy(target.API.(*mocks.Client), apiv11.TargetPolicy{Name: "failed", FailoverFailbackState: WritesEnabled})
+	mockTargetPolicy(target.API.(*mocks.Client), apiv11.TargetPolicy{Name: "lagging", FailoverFailbackState: WritesDisabled})
+	target.API.(*mocks.Client).On("Get", mock.Anything, "/platform/11/sync/target/policies/", "disabled", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("not found"))
+
+	assert.NoError(t, m.Poll(ctx))
+	types := map[string][]ReplicationEventType{}
+	for _, e := range events {
+		types[e.PolicyName] = append(types[e.PolicyName], e.Type)
+	}
+	assert.Equal(t, map[string][]ReplicationEventType{
+		"failed":   {EventJobFailed, EventTargetWritesEnabled},
+		"lagging":  {EventRPOBreached},
+		"disabled": {EventPolicyDisabled},
+	}, types)
+	for _, e := range events {
+		if e.Type == EventRPOBreached {
+			assert.Equal(t, 2*time.Hour, e.Lag)
+		}
+	}
+
+	// conditions that still hold are not reported again
+	events = nil
+	assert.NoError(t, m.Poll(ctx))
+	assert.Empty(t, events)
+
+	// a condition that cleared is reported again once it returns
+	failed.LastJobState = FINISHED
+	mockPolicies(source.API.(*mocks.Client), failed).Once()
+	assert.NoError(t, m.Poll(ctx))
+	failed.LastJobState = FAILED
+	mockPolicies(source.API.(*mocks.Client), failed).Once()
+	assert.NoError(t, m.Poll(ctx))
+	assert.Len(t, events, 1)
+	assert.Equal(t, EventJobFailed, events[0].Type)
+
+	unsubscribe()
+	events = nil
+	source.API.(*mocks.Client).On("Get", anyArgs[:6]...).Return(errors.New("unavailable")).Once()
+	assert.Error(t, m.Poll(ctx))
+	assert.Empty(t, events)
+}
+
+func TestReplicationMonitorCarryOver(t *testing.T) {
+	ctx := context.Background()
+	source := &Client{API: new(mocks.Client)}
+	target := &Client{API: new(mocks.Client)}
+	now := time.Unix(1700000000, 0)
+	m := NewReplicationMonitor(source, target, time.Second)
+	m.now = func() time.Time { return now }
+
+	var events []ReplicationEvent
+	m.Subscribe(func(e ReplicationEvent) { events = append(events, e) })
+
+	// a policy that never succeeded breaches its RPO once it has been polled for longer
+	never := apiv11.Policy{Name: "never", Enabled: true, Schedule: "when-source-modified", JobDelay: 3600}
+	mockPolicies(source.API.(*mocks.Client), never)
+	mockTargetPolicy(target.API.(*mocks.Client), apiv11.TargetPolicy{Name: "never", FailoverFailbackState: WritesEnabled}).Once()
+	assert.NoError(t, m.Poll(ctx))
+	assert.Len(t, events, 1)
+	assert.Equal(t, EventTargetWritesEnabled, events[0].Type)
+
+	// a failure to get the target policy keeps the writes enabled condition
+	events = nil
+	now = now.Add(2 * time.Hour)
+	target.API.(*mocks.Client).On("Get", anyArgs[:6]...).Return(errors.New("unavailable")).Once()
+	assert.NoError(t, m.Poll(ctx))
+	assert.Len(t, events, 1)
+	assert.Equal(t, EventRPOBreached, events[0].Type)
+	assert.Equal(t, 2*time.Hour, events[0].Lag)
+
+	events = nil
+	mockTargetPolicy(target.API.(*mocks.Client), apiv11.TargetPolicy{Name: "never", FailoverFailbackState: WritesEnabled}).Once()
+	assert.NoError(t, m.Poll(ctx))
+	assert.Empty(t, events)
+}
+
+func TestReplicationMonitorRun(t *testing.T) {
+	source := &Client{API: new(mocks.Client)}
+	m := NewReplicationMonitor(source, nil, time.Millisecond)
+	mockPolicies(source.API.(*mocks.Client))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, m.Run(ctx), context.DeadlineExceeded)
+}
@@ -0,0 +1,230 @@
+/*
+Copyright (c) 2025 Dell Inc, or its subsidiaries.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package goisilon
+
+import (
+	"context"
+	"iter"
+	"math"
+	"sort"
+	"time"
+
+	apiv11 "github.com/dell/goisilon/api/v11"
+)
+
+// reportPages returns an iterator over the reports of the pages returned by
+// fetch, which is called with an empty resume token for the first page.
+func reportPages(fetch func(resume string) ([]apiv11.Report, string, error)) iter.Seq2[apiv11.Report, error] {
+	return func(yield func(apiv11.Report, error) bool) {
+		resume := ""
+		for {
+			reports, next, err := fetch(resume)
+			if err != nil {
+				yield(apiv11.Report{}, err)
+				return
+			}
+			for _, report := range reports {
+				if !yield(report, nil) {
+					return
+				}
+			}
+			if next == "" {
+				return
+			}
+			resume = next
+		}
+	}
+}
+
+// ListReports returns an iterator over the SyncIQ reports matching filter.
+// Reports are requested from the cluster one page at a time as the iterator
+// advances. Iteration stops after the first error.
+func (c *Client) ListReports(ctx context.Context, filter *apiv11.ReportFilter) iter.Seq2[apiv11.Report, error] {
+	return reportPages(func(resume string) ([]apiv11.Report, string, error) {
+		page, err := apiv11.ListReports(ctx, c.API, filter, resume)
+		if err != nil {
+			return nil, "", err
+		}
+		return page.Reports, page.Resume, nil
+	})
+}
+
+// ListTargetReports returns an iterator over the reports of the SyncIQ jobs
+// replicating to this cluster that match filter.
+func (c *Client) ListTargetReports(ctx context.Context, filter *apiv11.ReportFilter) iter.Seq2[apiv11.Report, error] {
+	return reportPages(func(resume string) ([]apiv11.Report, string, error) {
+		page, err := apiv11.ListTargetReports(ctx, c.API, filter, resume)
+		if err != nil {
+			return nil, "", err
+		}
+		return page.Reports, page.Resume, nil
+	})
+}
+
+// ListSubreports returns an iterator over the sub-reports of a SyncIQ report,
+// one for each time the job was restarted.
+func (c *Client) ListSubreports(ctx context.Context, reportID string) iter.Seq2[apiv11.Report, error] {
+	return reportPages(func(resume string) ([]apiv11.Report, string, error) {
+		page, err := apiv11.ListSubreports(ctx, c.API, reportID, resume)
+		if err != nil {
+			return nil, "", err
+		}
+		return page.Subreports, page.Resume, nil
+	})
+}
+
+// RPOViolation is a period during which the data on the target cluster was
+// older than the RPO of its policy.
+type RPOViolation struct {
+	// From is when the RPO was exceeded and Until when a sync finished or the window ended.
+	From  time.Time
+	Until time.Time
+	// Lag is the age of the replicated data at Until.
+	Lag time.Duration
+}
+
+// RPOCompliance describes whether a policy met its RPO over a window.
+type RPOCompliance struct {
+	PolicyName string
+	RPO        time.Duration
+	// MaxLag is the largest age of the replicated data within the window.
+	MaxLag          time.Duration
+	Compliant       bool
+	SuccessfulSyncs int
+	Violations      []RPOViolation
+}
+
+// rpoThreshold returns the RPO of a policy, or 0 if it has none: its RPO
+// alert, or else the job delay of a policy syncing when the source is modified.
+func rpoThreshold(policy apiv11.Policy) time.Duration {
+	if policy.RPOAlert != nil && *policy.RPOAlert > 0 {
+		return time.Duration(*policy.RPOAlert) * time.Second
+	}
+	if policy.Schedule == "when-source-modified" && policy.JobDelay > 0 {
+		return time.Duration(policy.JobDelay) * time.Second
+	}
+	return 0
+}
+
+func isSuccessfulReport(r apiv11.Report) bool {
+	return r.State == FINISHED || r.State == SKIPPED
+}
+
+// CalculateRPOCompliance works out whether a policy with the given RPO met it
+// between windowStart and windowEnd, using the reports of its jobs.
+//
+// A finished sync makes the data as of its start time available on the target
+// once it ends, so the age of the replicated data peaks just before each sync
+// ends and at windowEnd. The state at the start of the window is established
+// by the latest of lastSuccess, the start time of the last successful job of
+// the policy, and the reports of jobs that finished before windowStart. If
+// neither shows that a sync ran before the window, the data cannot be shown
+// to have been current at windowStart and the time until the first sync of
+// the window ends is a violation, whose Lag is then only a lower bound.
+func CalculateRPOCompliance(
+	policyName string, rpo time.Duration, lastSuccess time.Time, reports []apiv11.Report, windowStart, windowEnd time.Time,
+) RPOCompliance {
+	result := RPOCompliance{PolicyName: policyName, RPO: rpo, Compliant: true}
+
+	var syncs []apiv11.Report
+	for _, r := range reports {
+		if isSuccessfulReport(r) && r.EndTime != 0 && r.EndTime <= windowEnd.Unix() {
+			syncs = append(syncs, r)
+		}
+	}
+	sort.Slice(syncs, func(i, j int) bool { return syncs[i].EndTime < syncs[j].EndTime })
+
+	// recoveryPoint is the time as of which the target data is current, zero while unknown
+	var recoveryPoint time.Time
+	if !lastSuccess.IsZero() && !lastSuccess.After(windowStart) {
+		recoveryPoint = lastSuccess
+	}
+	check := func(at time.Time) {
+		if recoveryPoint.IsZero() {
+			lag := at.Sub(windowStart)
+			result.MaxLag = max(result.MaxLag, lag)
+			result.Compliant = false
+			result.Violations = append(result.Violations, RPOViolation{From: windowStart, Until: at, Lag: lag})
+			return
+		}
+		lag := at.Sub(recoveryPoint)
+		result.MaxLag = max(result.MaxLag, lag)
+		if lag > rpo {
+			result.Compliant = false
+			from := recoveryPoint.Add(rpo)
+			if from.Before(windowStart) {
+				from = windowStart
+			}
+			result.Violations = append(result.Violations, RPOViolation{From: from, Until: at, Lag: lag})
+		}
+	}
+
+	for _, r := range syncs {
+		end := time.Unix(r.EndTime, 0)
+		start := time.Unix(r.StartTime, 0)
+		if !end.After(windowStart) {
+			// finished before the window, it is the state at the window start
+			if start.After(recoveryPoint) {
+				recoveryPoint = start
+			}
+			continue
+		}
+		check(end)
+		result.SuccessfulSyncs++
+		if start.After(recoveryPoint) {
+			recoveryPoint = start
+		}
+	}
+	check(windowEnd)
+	return result
+}
+
+// GetRPOCompliance works out whether each enabled policy with an RPO met it
+// over the window ending now. The RPO is the one ReplicationMonitor checks.
+func (c *Client) GetRPOCompliance(ctx context.Context, window time.Duration) ([]RPOCompliance, error) {
+	policies, err := c.GetPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	windowEnd := time.Now()
+	windowStart := windowEnd.Add(-window)
+
+	var results []RPOCompliance
+	for _, policy := range policies {
+		rpo := rpoThreshold(policy)
+		if !policy.Enabled || rpo <= 0 {
+			continue
+		}
+		// look back one RPO before the window to find the sync current at its start
+		filter := &apiv11.ReportFilter{
+			PolicyName: policy.Name,
+			NewerThan:  int(math.Ceil((window+rpo).Hours()/24)) + 1,
+		}
+		var reports []apiv11.Report
+		for report, err := range c.ListReports(ctx, filter) {
+			if err != nil {
+				return nil, err
+			}
+			reports = append(reports, report)
+		}
+		var lastSuccess time.Time
+		if policy.LastSuccess > 0 {
+			lastSuccess = time.Unix(policy.LastSuccess, 0)
+		}
+		results = append(results, CalculateRPOCompliance(policy.Name, rpo, lastSuccess, reports, windowStart, windowEnd))
+	}
+	return results, nil
+}
@@ -0,0 +1,130 @@
+/*
+Copyright (c) 2025 Dell Inc, or its subsidiaries.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package goisilon
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dell/goisilon/api"
+	apiv11 "github.com/dell/goisilon/api/v11"
+	"github.com/dell/goisilon/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestListReports(t *testing.T) {
+	ctx := context.Background()
+	client := &Client{API: new(mocks.Client)}
+
+	client.API.(*mocks.Client).On("Get", ctx, "/platform/11/sync/reports", "", api.OrderedValues{{[]byte("policy_name"), []byte("policy")}}, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(**apiv11.Reports)
+		*resp = &apiv11.Reports{Reports: []apiv11.Report{{ID: "1"}}, Resume: "next"}
+	}).Once()
+	client.API.(*mocks.Client).On("Get", ctx, "/platform/11/sync/reports", "", api.OrderedValues{{[]byte("resume"), []byte("next")}}, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(**apiv11.Reports)
+		*resp = &apiv11.Reports{Reports: []apiv11.Report{{ID: "2"}, {ID: "3"}}}
+	}).Once()
+
+	var ids []string
+	for report, err := range client.ListReports(ctx, &apiv11.ReportFilter{PolicyName: "policy"}) {
+		assert.NoError(t, err)
+		ids = append(ids, report.ID)
+		if report.ID == "2" {
+			break
+		}
+	}
+	assert.Equal(t, []string{"1", "2"}, ids)
+
+	client.API.(*mocks.Client).On("Get", ctx, "/platform/11/sync/reports/1/subreports", "", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("error")).Once()
+	for _, err := range client.ListSubreports(ctx, "1") {
+		assert.Error(t, err)
+	}
+}
+
+func TestCalculateRPOCompliance(t *testing.T) {
+	base := time.Unix(1700000000, 0)
+	at := func(hours float64) int64 { return base.Add(time.Duration(hours * float64(time.Hour))).Unix() }
+	report := func(state apiv11.JobState, start, end float64) apiv11.Report {
+		return apiv11.Report{State: state, EndTime: at(end), JobStatistics: apiv11.JobStatistics{StartTime: at(start)}}
+	}
+	reports := []apiv11.Report{
+		report(FINISHED, 4, 4.5),
+		report(FINISHED, -1, -0.5),
+		report(FINISHED, 0.5, 1),
+		report(FAILED, 6, 6.5),
+	}
+
+	result := CalculateRPOCompliance("policy", 2*time.Hour, time.Time{}, reports, base, base.Add(10*time.Hour))
+	assert.False(t, result.Compliant)
+	assert.Equal(t, 2, result.SuccessfulSyncs)
+	assert.Equal(t, 6*time.Hour, result.MaxLag)
+	assert.Equal(t, []RPOViolation{
+		{From: time.Unix(at(2.5), 0), Until: time.Unix(at(4.5), 0), Lag: 4 * time.Hour},
+		{From: time.Unix(at(6), 0), Until: time.Unix(at(10), 0), Lag: 6 * time.Hour},
+	}, result.Violations)
+
+	result = CalculateRPOCompliance("policy", 7*time.Hour, time.Time{}, reports, base, base.Add(10*time.Hour))
+	assert.True(t, result.Compliant)
+	assert.Empty(t, result.Violations)
+
+	// without a sync before the window, the time until the first one is a violation
+	inWindow := []apiv11.Report{report(FINISHED, 0.5, 1), report(FINISHED, 4, 4.5)}
+	result = CalculateRPOCompliance("policy", 7*time.Hour, time.Time{}, inWindow, base, base.Add(10*time.Hour))
+	assert.False(t, result.Compliant)
+	assert.Equal(t, []RPOViolation{{From: base, Until: time.Unix(at(1), 0), Lag: time.Hour}}, result.Violations)
+
+	// the last success of the policy shows how old the data was at the window start
+	result = CalculateRPOCompliance("policy", 2*time.Hour, time.Unix(at(-3), 0), inWindow, base, base.Add(10*time.Hour))
+	assert.False(t, result.Compliant)
+	assert.Equal(t, 6*time.Hour, result.MaxLag)
+	assert.Len(t, result.Violations, 3)
+	assert.Equal(t, RPOViolation{From: base, Until: time.Unix(at(1), 0), Lag: 4 * time.Hour}, result.Violations[0])
+}
+
+func TestGetRPOCompliance(t *testing.T) {
+	ctx := context.Background()
+	client := &Client{API: new(mocks.Client)}
+	now := time.Now()
+
+	client.API.(*mocks.Client).On("Get", ctx, "/platform/11/sync/policies/", "", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(**apiv11.Policies)
+		*resp = &apiv11.Policies{Policy: []apiv11.Policy{
+			{Name: "enabled", Enabled: true, Schedule: "when-source-modified", JobDelay: 3600, LastSuccess: now.Add(-65 * time.Minute).Unix()},
+			{Name: "disabled", Enabled: false, JobDelay: 3600},
+			{Name: "manual", Enabled: true},
+			{Name: "scheduled", Enabled: true, Schedule: "every 1 days", JobDelay: 3600},
+		}}
+	}).Once()
+	client.API.(*mocks.Client).On("Get", ctx, "/platform/11/sync/reports", "", api.OrderedValues{
+		{[]byte("policy_name"), []byte("enabled")},
+		{[]byte("newer_than"), []byte("2")},
+	}, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(**apiv11.Reports)
+		*resp = &apiv11.Reports{Reports: []apiv11.Report{
+			{State: FINISHED, EndTime: now.Add(-10 * time.Minute).Unix(), JobStatistics: apiv11.JobStatistics{StartTime: now.Add(-20 * time.Minute).Unix()}},
+		}}
+	}).Once()
+
+	results, err := client.GetRPOCompliance(ctx, time.Hour)
+	assert.NoError(t, err)
+	assert.Len(t, results, 1)
+	assert.Equal(t, "enabled", results[0].PolicyName)
+	assert.True(t, results[0].Compliant)
+	assert.Equal(t, 1, results[0].SuccessfulSyncs)
+}
@@ -15,11 +15,14 @@ package goisilon
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/dell/goisilon/api"
+	"github.com/dell/goisilon/api/common/utils/poll"
 	apiv11 "github.com/dell/goisilon/api/v11"
 	"github.com/dell/goisilon/mocks"
 	"github.com/stretchr/testify/assert"
@@ -883,6 +886,22 @@ func TestDisallowWrites(t *testing.T) {
 	})
 }
 
+func TestDisallowWritesWithOptionsTimeout(t *testing.T) {
+	ctx := context.Background()
+	client := &Client{API: new(mocks.Client)}
+
+	mockTargetPolicy(client.API.(*mocks.Client), apiv11.TargetPolicy{Name: "policy", FailoverFailbackState: WritesEnabled})
+	client.API.(*mocks.Client).On("Post", mock.Anything, jobsPath, "", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		assert.Equal(t, apiv11.AllowWriteRevert, args.Get(5).(*apiv11.JobRequest).Action)
+	}).Once()
+
+	start := time.Now()
+	err := client.DisallowWritesWithOptions(ctx, "policy", WaitOptions{Interval: 5 * time.Millisecond, Timeout: 50 * time.Millisecond})
+	assert.ErrorIs(t, err, poll.ErrWaitTimeout)
+	assert.ErrorContains(t, err, "last observed state "+string(WritesEnabled))
+	assert.Less(t, time.Since(start), defaultPoll)
+}
+
 func TestResyncPrep(t *testing.T) {
 	ctx := context.Background()
 	client := &Client{API: new(mocks.Client)}
@@ -1796,3 +1815,187 @@ func TestFilterReports(t *testing.T) {
 		assert.Equal(t, expectedFiltered, filteredReports)
 	})
 }
+
+func TestPolicyCRUDWithParams(t *testing.T) {
+	ctx := context.Background()
+	client := &Client{API: new(mocks.Client)}
+
+	alert := 3600
+	client.API.(*mocks.Client).On("Post", ctx, policiesPath, "", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		assert.Equal(t, &alert, args.Get(5).(*apiv11.Policy).RPOAlert)
+		args.Get(6).(*apiv11.Policy).ID = "id-1"
+	}).Once()
+	id, err := client.CreatePolicyWithParams(ctx, &apiv11.Policy{Name: "p1", RPOAlert: &alert})
+	assert.NoError(t, err)
+	assert.Equal(t, "id-1", id)
+
+	client.API.(*mocks.Client).On("Get", ctx, policiesPath, "", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(**apiv11.Policies)
+		*resp = &apiv11.Policies{Policy: []apiv11.Policy{{ID: "id-1", Name: "p1"}}}
+	}).Once()
+	policies, err := client.GetPolicies(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, policies, 1)
+
+	enabled := false
+	client.API.(*mocks.Client).On("Put", ctx, policiesPath, "p1", mock.Anything, mock.Anything, &apiv11.PolicyUpdate{Enabled: &enabled}, mock.Anything).Return(nil).Once()
+	assert.NoError(t, client.UpdatePolicyWithParams(ctx, "p1", &apiv11.PolicyUpdate{Enabled: &enabled}))
+}
+
+func TestSyncIQJobControl(t *testing.T) {
+	ctx := context.Background()
+	client := &Client{API: new(mocks.Client)}
+
+	client.API.(*mocks.Client).On("Get", ctx, jobsPath, "policy", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(**apiv11.Jobs)
+		*resp = &apiv11.Jobs{Job: []apiv11.Job{{ID: "policy", State: RUNNING, JobStatistics: apiv11.JobStatistics{FilesTransferred: 10, TotalFiles: 100}}}}
+	}).Once()
+	job, err := client.GetSyncIQJob(ctx, "policy")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), job.FilesTransferred)
+
+	for _, state := range []apiv11.JobState{PAUSED, RUNNING, CANCELED} {
+		client.API.(*mocks.Client).On("Put", ctx, jobsPath, "policy", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			body, _ := json.Marshal(args.Get(5))
+			assert.JSONEq(t, `{"state":"`+string(state)+`"}`, string(body))
+		}).Once()
+	}
+	assert.NoError(t, client.PauseSyncIQJob(ctx, "policy"))
+	assert.NoError(t, client.ResumeSyncIQJob(ctx, "policy"))
+	assert.NoError(t, client.CancelSyncIQJob(ctx, "policy"))
+}
+
+func TestSyncRulesAndSettings(t *testing.T) {
+	ctx := context.Background()
+	client := &Client{API: new(mocks.Client)}
+
+	client.API.(*mocks.Client).On("Post", ctx, "/platform/11/sync/rules/", "", mock.Anything, mock.Anything, &apiv11.SyncRule{
+		Type:     apiv11.BandwidthRule,
+		Limit:    1000,
+		Enabled:  true,
+		Schedule: &apiv11.SyncRuleSchedule{Begin: "08:00", End: "18:00", Monday: true, Tuesday: true},
+	}, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		args.Get(6).(*apiv11.SyncRule).ID = "bw-0"
+	}).Once()
+	id, err := client.CreateBandwidthRule(ctx, 1000, "08:00", "18:00", time.Monday, time.Tuesday)
+	assert.NoError(t, err)
+	assert.Equal(t, "bw-0", id)
+
+	client.API.(*mocks.Client).On("Get", ctx, "/platform/11/sync/rules/", "", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(**apiv11.SyncRules)
+		*resp = &apiv11.SyncRules{Rules: []apiv11.SyncRule{{ID: "bw-0"}}}
+	}).Once()
+	rules, err := client.GetSyncRules(ctx, "")
+	assert.NoError(t, err)
+	assert.Len(t, rules, 1)
+
+	client.API.(*mocks.Client).On("Delete", ctx, "/platform/11/sync/rules/", "bw-0", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	assert.NoError(t, client.DeleteSyncRule(ctx, "bw-0"))
+
+	client.API.(*mocks.Client).On("Get", ctx, "/platform/11/sync/settings", "", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(**apiv11.SyncSettingsResp)
+		*resp = &apiv11.SyncSettingsResp{Settings: &apiv11.SyncSettings{Service: apiv11.SyncServiceOn}}
+	}).Once()
+	settings, err := client.GetSyncSettings(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, apiv11.SyncServiceOn, settings.Service)
+
+	required := true
+	client.API.(*mocks.Client).On("Put", ctx, "/platform/11/sync/settings", "", mock.Anything, mock.Anything, &apiv11.SyncSettingsUpdate{EncryptionRequired: &required}, mock.Anything).Return(nil).Once()
+	assert.NoError(t, client.UpdateSyncSettings(ctx, &apiv11.SyncSettingsUpdate{EncryptionRequired: &required}))
+}
+
+func TestWaitForPolicyLastJobStateWithOptions(t *testing.T) {
+	ctx := context.Background()
+	client := &Client{API: new(mocks.Client)}
+
+	states := []apiv11.JobState{RUNNING, RUNNING, FINISHED}
+	for _, state := range states {
+		client.API.(*mocks.Client).On("Get", mock.Anything, policiesPath, "policy", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			resp := args.Get(5).(**apiv11.Policies)
+			*resp = &apiv11.Policies{Policy: []apiv11.Policy{{Name: "policy", LastJobState: state}}}
+		}).Once()
+	}
+
+	var progress []WaitProgress
+	opts := WaitOptions{
+		Interval:    time.Millisecond,
+		Timeout:     time.Second,
+		Backoff:     2,
+		MaxInterval: 3 * time.Millisecond,
+		OnProgress:  func(p WaitProgress) { progress = append(progress, p) },
+	}
+	err := client.WaitForPolicyLastJobStateWithOptions(ctx, "policy", opts, FINISHED)
+	assert.NoError(t, err)
+	assert.Len(t, progress, 2)
+	assert.Equal(t, 2, progress[1].Attempt)
+	assert.Equal(t, string(RUNNING), progress[1].State)
+
+}
+
+func TestWaitForNoActiveJobsWithOptionsTimeout(t *testing.T) {
+	ctx := context.Background()
+	client := &Client{API: new(mocks.Client)}
+
+	client.API.(*mocks.Client).On("Get", mock.Anything, jobsPath, "policy", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(**apiv11.Jobs)
+		*resp = &apiv11.Jobs{Job: []apiv11.Job{{ID: "policy"}}}
+	})
+
+	start := time.Now()
+	err := client.WaitForNoActiveJobsWithOptions(ctx, "policy", WaitOptions{Interval: 5 * time.Millisecond, Timeout: 50 * time.Millisecond})
+	assert.ErrorIs(t, err, poll.ErrWaitTimeout)
+	assert.ErrorContains(t, err, "last observed state 1")
+	assert.Less(t, time.Since(start), defaultPoll)
+}
+
+func TestSyncPolicyWithOptionsCanceled(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	client := &Client{API: new(mocks.Client)}
+
+	client.API.(*mocks.Client).On("Get", ctx, policiesPath, "policy", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(**apiv11.Policies)
+		*resp = &apiv11.Policies{Policy: []apiv11.Policy{{Name: "policy", Enabled: true}}}
+	}).Once()
+	client.API.(*mocks.Client).On("Get", ctx, jobsPath, "policy", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	client.API.(*mocks.Client).On("Post", ctx, jobsPath, "", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+
+	start := time.Now()
+	err := client.SyncPolicyWithOptions(ctx, "policy", SyncOptions{StartDelay: time.Minute})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), time.Minute)
+}
+
+func TestSyncPolicyWithOptionsDefaults(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	client := &Client{API: new(mocks.Client)}
+
+	client.API.(*mocks.Client).On("Get", ctx, policiesPath, "policy", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(**apiv11.Policies)
+		*resp = &apiv11.Policies{Policy: []apiv11.Policy{{Name: "policy", Enabled: true}}}
+	}).Once()
+	client.API.(*mocks.Client).On("Get", ctx, jobsPath, "policy", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	client.API.(*mocks.Client).On("Post", ctx, jobsPath, "", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+
+	// the started job is given the default start delay to show up before waiting for it
+	err := client.SyncPolicyWithOptions(ctx, "policy", SyncOptions{})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	client.API.(*mocks.Client).AssertNumberOfCalls(t, "Get", 2)
+}
+
+func TestWaitForNoActiveJobsWithOptionsNoTimeout(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	client := &Client{API: new(mocks.Client)}
+
+	client.API.(*mocks.Client).On("Get", mock.Anything, jobsPath, "policy", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(**apiv11.Jobs)
+		*resp = &apiv11.Jobs{Job: []apiv11.Job{{ID: "policy"}}}
+	})
+
+	// without a timeout the wait only ends with the context
+	err := client.WaitForNoActiveJobsWithOptions(ctx, "policy", WaitOptions{Interval: 5 * time.Millisecond})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
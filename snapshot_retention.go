@@ -0,0 +1,233 @@
+/*
+Copyright (c) 2025 Dell Inc, or its subsidiaries.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package goisilon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	log "github.com/akutz/gournal"
+	api "github.com/dell/goisilon/api/v1"
+)
+
+// Reasons a snapshot is kept or deleted by a retention plan.
+const (
+	RetentionReasonHourly      = "hourly"
+	RetentionReasonDaily       = "daily"
+	RetentionReasonWeekly      = "weekly"
+	RetentionReasonLocked      = "locked"
+	RetentionReasonExpires     = "expires"
+	RetentionReasonDeleting    = "deleting"
+	RetentionReasonNotRetained = "not retained"
+)
+
+// SnapshotRetentionPolicy describes which snapshots of a path are kept using
+// grandfather-father-son rules. The newest snapshot of each of the Hourly
+// most recent hours, Daily most recent days and Weekly most recent weeks that
+// have snapshots is kept, all other snapshots are deleted.
+type SnapshotRetentionPolicy struct {
+	Hourly int
+	Daily  int
+	Weekly int
+
+	// NamePrefix restricts the policy to snapshots whose name starts with
+	// the prefix. Other snapshots of the path are left alone.
+	NamePrefix string
+
+	// Location determines the day and week boundaries, it defaults to UTC.
+	Location *time.Location
+}
+
+// SnapshotRetentionDecision is the decision a retention plan made for a snapshot.
+type SnapshotRetentionDecision struct {
+	Snapshot Snapshot
+	Reason   string
+}
+
+// SnapshotRetentionPlan lists the snapshots of a path a retention policy
+// keeps and the snapshots it deletes.
+type SnapshotRetentionPlan struct {
+	Path   string
+	Keep   []SnapshotRetentionDecision
+	Delete []SnapshotRetentionDecision
+}
+
+func (p SnapshotRetentionPolicy) validate() error {
+	if p.Hourly < 0 || p.Daily < 0 || p.Weekly < 0 {
+		return errors.New("retention counts cannot be negative")
+	}
+	if p.Hourly+p.Daily+p.Weekly == 0 {
+		return errors.New("retention policy does not keep any snapshots")
+	}
+	return nil
+}
+
+// retentionTier selects the newest snapshot of each of the count most recent
+// buckets returned by bucketKey.
+type retentionTier struct {
+	reason    string
+	count     int
+	bucketKey func(time.Time) string
+}
+
+func (p SnapshotRetentionPolicy) tiers() []retentionTier {
+	return []retentionTier{
+		{RetentionReasonHourly, p.Hourly, func(t time.Time) string { return t.Format("2006-01-02T15") }},
+		{RetentionReasonDaily, p.Daily, func(t time.Time) string { return t.Format("2006-01-02") }},
+		{RetentionReasonWeekly, p.Weekly, func(t time.Time) string {
+			year, week := t.ISOWeek()
+			return fmt.Sprintf("%d-W%02d", year, week)
+		}},
+	}
+}
+
+// PlanSnapshotRetentionForSnapshots builds a retention plan for the given
+// snapshots without making any changes on the cluster. Snapshots that hold
+// locks, have an expiry set (OneFS deletes those itself) or are already
+// being deleted are always kept.
+func PlanSnapshotRetentionForSnapshots(
+	path string, snapshots SnapshotList, policy SnapshotRetentionPolicy,
+) (*SnapshotRetentionPlan, error) {
+	if err := policy.validate(); err != nil {
+		return nil, err
+	}
+	loc := policy.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	plan := &SnapshotRetentionPlan{Path: path}
+	var candidates SnapshotList
+	for _, snapshot := range snapshots {
+		if !strings.HasPrefix(snapshot.Name, policy.NamePrefix) {
+			continue
+		}
+		switch {
+		case snapshot.HasLocks:
+			plan.Keep = append(plan.Keep, SnapshotRetentionDecision{snapshot, RetentionReasonLocked})
+		case snapshot.Expires != 0:
+			plan.Keep = append(plan.Keep, SnapshotRetentionDecision{snapshot, RetentionReasonExpires})
+		case snapshot.State == "deleting":
+			plan.Keep = append(plan.Keep, SnapshotRetentionDecision{snapshot, RetentionReasonDeleting})
+		default:
+			candidates = append(candidates, snapshot)
+		}
+	}
+
+	// newest first, so the first snapshot seen in a bucket is the one kept
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].Created == candidates[j].Created {
+			return candidates[i].ID > candidates[j].ID
+		}
+		return candidates[i].Created > candidates[j].Created
+	})
+
+	kept := make(map[int64]string, len(candidates))
+	for _, tier := range policy.tiers() {
+		buckets := make(map[string]bool, tier.count)
+		for _, snapshot := range candidates {
+			if len(buckets) == tier.count {
+				break
+			}
+			key := tier.bucketKey(time.Unix(snapshot.Created, 0).In(loc))
+			if buckets[key] {
+				continue
+			}
+			buckets[key] = true
+			if _, ok := kept[snapshot.ID]; !ok {
+				kept[snapshot.ID] = tier.reason
+			}
+		}
+	}
+
+	for _, snapshot := range candidates {
+		if reason, ok := kept[snapshot.ID]; ok {
+			plan.Keep = append(plan.Keep, SnapshotRetentionDecision{snapshot, reason})
+		} else {
+			plan.Delete = append(plan.Delete, SnapshotRetentionDecision{snapshot, RetentionReasonNotRetained})
+		}
+	}
+	return plan, nil
+}
+
+// PlanSnapshotRetention builds a retention plan for the snapshots of the
+// volume at path, as returned by GetSnapshotsByPath, without making any
+// changes on the cluster.
+func (c *Client) PlanSnapshotRetention(
+	ctx context.Context, path string, policy SnapshotRetentionPolicy,
+) (*SnapshotRetentionPlan, error) {
+	snapshots, err := c.GetSnapshotsByPath(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	return PlanSnapshotRetentionForSnapshots(path, snapshots, policy)
+}
+
+// ApplySnapshotRetention deletes the snapshots a retention plan marked for
+// deletion. Each snapshot is queried again first and skipped if it has been
+// locked or given an expiry since the plan was made. All snapshots are
+// attempted, the returned error joins every failure.
+func (c *Client) ApplySnapshotRetention(
+	ctx context.Context, plan *SnapshotRetentionPlan,
+) error {
+	var errs []error
+	for _, decision := range plan.Delete {
+		if err := ctx.Err(); err != nil {
+			return errors.Join(append(errs, err)...)
+		}
+		current, err := api.GetIsiSnapshot(ctx, c.API, decision.Snapshot.ID)
+		if err != nil {
+			if isNotFoundError(err) {
+				continue
+			}
+			errs = append(errs, err)
+			continue
+		}
+		if current.HasLocks || current.Expires != 0 {
+			log.Info(ctx, "snapshot '%s' changed since the retention plan was made, skipping it", current.Name)
+			continue
+		}
+		log.Debug(ctx, "removing snapshot '%s' of path '%s'", current.Name, plan.Path)
+		if err := api.RemoveIsiSnapshot(ctx, c.API, current.ID); err != nil {
+			errs = append(errs, fmt.Errorf("failed to remove snapshot %s: %w", current.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// CreateSnapshotWithRetention creates a snapshot of the volume at path and
+// then deletes the snapshots of the path the retention policy no longer
+// keeps. The executed plan is returned along with the new snapshot.
+func (c *Client) CreateSnapshotWithRetention(
+	ctx context.Context, path, snapshotName string, policy SnapshotRetentionPolicy,
+) (Snapshot, *SnapshotRetentionPlan, error) {
+	if err := policy.validate(); err != nil {
+		return nil, nil, err
+	}
+	snapshot, err := c.CreateSnapshotWithPath(ctx, c.API.VolumePath(path), snapshotName)
+	if err != nil {
+		return nil, nil, err
+	}
+	plan, err := c.PlanSnapshotRetention(ctx, path, policy)
+	if err != nil {
+		return snapshot, nil, err
+	}
+	return snapshot, plan, c.ApplySnapshotRetention(ctx, plan)
+}
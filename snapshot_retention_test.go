@@ -0,0 +1,105 @@
+/*
+Copyright (c) 2025 Dell Inc, or its subsidiaries.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package goisilon
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	apiv1 "github.com/dell/goisilon/api/v1"
+	"github.com/dell/goisilon/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func retentionDecisionIDs(decisions []SnapshotRetentionDecision) map[int64]string {
+	ids := make(map[int64]string, len(decisions))
+	for _, d := range decisions {
+		ids[d.Snapshot.ID] = d.Reason
+	}
+	return ids
+}
+
+func TestPlanSnapshotRetentionForSnapshots(t *testing.T) {
+	base := time.Date(2025, 3, 12, 10, 30, 0, 0, time.UTC) // a Wednesday
+	at := func(d time.Duration) int64 { return base.Add(-d).Unix() }
+	snapshots := SnapshotList{
+		{ID: 1, Name: "gfs-1", Created: at(0)},
+		{ID: 2, Name: "gfs-2", Created: at(10 * time.Minute)},
+		{ID: 3, Name: "gfs-3", Created: at(time.Hour)},
+		{ID: 4, Name: "gfs-4", Created: at(2 * time.Hour)},
+		{ID: 5, Name: "gfs-5", Created: at(24 * time.Hour)},
+		{ID: 6, Name: "gfs-6", Created: at(48 * time.Hour)},
+		{ID: 7, Name: "gfs-7", Created: at(8 * 24 * time.Hour)},
+		{ID: 8, Name: "gfs-8", Created: at(30 * 24 * time.Hour)},
+		{ID: 9, Name: "gfs-9", Created: at(40 * 24 * time.Hour), HasLocks: true},
+		{ID: 10, Name: "gfs-10", Created: at(41 * 24 * time.Hour), Expires: base.Unix()},
+		{ID: 11, Name: "manual", Created: at(50 * 24 * time.Hour)},
+	}
+
+	plan, err := PlanSnapshotRetentionForSnapshots("vol", snapshots, SnapshotRetentionPolicy{
+		Hourly: 2, Daily: 2, Weekly: 2, NamePrefix: "gfs-",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[int64]string{
+		1:  RetentionReasonHourly,
+		3:  RetentionReasonHourly,
+		5:  RetentionReasonDaily,
+		7:  RetentionReasonWeekly,
+		9:  RetentionReasonLocked,
+		10: RetentionReasonExpires,
+	}, retentionDecisionIDs(plan.Keep))
+	assert.Equal(t, map[int64]string{
+		2: RetentionReasonNotRetained,
+		4: RetentionReasonNotRetained,
+		6: RetentionReasonNotRetained,
+		8: RetentionReasonNotRetained,
+	}, retentionDecisionIDs(plan.Delete))
+
+	_, err = PlanSnapshotRetentionForSnapshots("vol", snapshots, SnapshotRetentionPolicy{})
+	assert.ErrorContains(t, err, "does not keep any snapshots")
+	_, err = PlanSnapshotRetentionForSnapshots("vol", snapshots, SnapshotRetentionPolicy{Hourly: -1, Daily: 2})
+	assert.ErrorContains(t, err, "negative")
+}
+
+func TestApplySnapshotRetention(t *testing.T) {
+	client := &Client{API: new(mocks.Client)}
+	ctx := context.Background()
+	api := client.API.(*mocks.Client)
+
+	mockSnapshot(api, &apiv1.IsiSnapshot{ID: 1, Name: "gfs-1"})
+	mockSnapshot(api, &apiv1.IsiSnapshot{ID: 2, Name: "gfs-2", HasLocks: true})
+	api.On("Delete", mock.Anything, "platform/1/snapshot/snapshots/1", "", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+
+	plan := &SnapshotRetentionPlan{
+		Path: "vol",
+		Delete: []SnapshotRetentionDecision{
+			{Snapshot: &apiv1.IsiSnapshot{ID: 1, Name: "gfs-1"}, Reason: RetentionReasonNotRetained},
+			{Snapshot: &apiv1.IsiSnapshot{ID: 2, Name: "gfs-2"}, Reason: RetentionReasonNotRetained},
+		},
+	}
+	assert.NoError(t, client.ApplySnapshotRetention(ctx, plan))
+	api.AssertNumberOfCalls(t, "Delete", 1)
+
+	// the errors of the removals keep their chain
+	busy := errors.New("snapshot busy")
+	api.On("Delete", mock.Anything, "platform/1/snapshot/snapshots/1", "", mock.Anything, mock.Anything, mock.Anything).Return(busy).Once()
+	err := client.ApplySnapshotRetention(ctx, plan)
+	assert.ErrorIs(t, err, busy)
+	assert.ErrorContains(t, err, "failed to remove snapshot gfs-1")
+}
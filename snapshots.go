@@ -23,8 +23,11 @@ import (
 	"path"
 	"strconv"
 	"strings"
+	"time"
 
+	log "github.com/akutz/gournal"
 	api "github.com/dell/goisilon/api/v1"
+	apiv14 "github.com/dell/goisilon/api/v14"
 )
 
 const (
@@ -38,6 +41,33 @@ type SnapshotList []*api.IsiSnapshot
 // Snapshot represents an Isilon snapshot.
 type Snapshot *api.IsiSnapshot
 
+// SnapshotLock represents a lock held on an Isilon snapshot.
+type SnapshotLock *api.IsiSnapshotLock
+
+// SnapshotLockList represents a list of locks held on an Isilon snapshot.
+type SnapshotLockList []*api.IsiSnapshotLock
+
+// SnapshotAlias represents an alias pointing at an Isilon snapshot.
+type SnapshotAlias *api.IsiSnapshotAlias
+
+// SnapshotAliasList represents a list of Isilon snapshot aliases.
+type SnapshotAliasList []*api.IsiSnapshotAlias
+
+// WritableSnapshot represents an Isilon writable snapshot.
+type WritableSnapshot *apiv14.IsiWritableSnapshot
+
+// WritableSnapshotList represents a list of Isilon writable snapshots.
+type WritableSnapshotList []*apiv14.IsiWritableSnapshot
+
+// PendingSnapshot represents a snapshot a schedule will create in the future.
+type PendingSnapshot *api.IsiPendingSnapshot
+
+// PendingSnapshotList represents a list of snapshots schedules will create in the future.
+type PendingSnapshotList []*api.IsiPendingSnapshot
+
+// SnapshotSettings represents the cluster wide snapshot settings.
+type SnapshotSettings *api.IsiSnapshotSettings
+
 // GetSnapshots returns a list of snapshots from the cluster.
 func (c *Client) GetSnapshots(ctx context.Context) (SnapshotList, error) {
 	snapshots, err := api.GetIsiSnapshots(ctx, c.API)
@@ -259,3 +289,396 @@ func (c *Client) GetSnapshotIsiPath(
 	}
 	return path.Join(zone.Path, snapShot, snapshot.Name, path.Base(snapshot.Path)), nil
 }
+
+// getExistingSnapshot returns the snapshot matching id or name, or an error if it does not exist.
+func (c *Client) getExistingSnapshot(
+	ctx context.Context, id int64, name string,
+) (Snapshot, error) {
+	snapshot, err := c.GetSnapshot(ctx, id, name)
+	if err != nil {
+		return nil, err
+	}
+	if snapshot == nil {
+		return nil, fmt.Errorf("Snapshot doesn't exist: (%d, %s)", id, name)
+	}
+	return snapshot, nil
+}
+
+// RenameSnapshot renames the snapshot matching id, or failing that, the snapshot matching name.
+func (c *Client) RenameSnapshot(
+	ctx context.Context, id int64, name, newName string,
+) error {
+	if newName == "" {
+		return errors.New("no new snapshot name set")
+	}
+	snapshot, err := c.getExistingSnapshot(ctx, id, name)
+	if err != nil {
+		return err
+	}
+	return api.UpdateIsiSnapshot(ctx, c.API, snapshot.ID, &api.IsiUpdateSnapshotReq{Name: newName})
+}
+
+// SetSnapshotExpiry sets the time at which the snapshot matching id or name is deleted by OneFS.
+func (c *Client) SetSnapshotExpiry(
+	ctx context.Context, id int64, name string, expires time.Time,
+) error {
+	if expires.IsZero() {
+		return c.ClearSnapshotExpiry(ctx, id, name)
+	}
+	snapshot, err := c.getExistingSnapshot(ctx, id, name)
+	if err != nil {
+		return err
+	}
+	epoch := expires.Unix()
+	return api.UpdateIsiSnapshot(ctx, c.API, snapshot.ID, &api.IsiUpdateSnapshotReq{Expires: &epoch})
+}
+
+// ClearSnapshotExpiry removes the expiry of the snapshot matching id or name so it is kept indefinitely.
+func (c *Client) ClearSnapshotExpiry(
+	ctx context.Context, id int64, name string,
+) error {
+	snapshot, err := c.getExistingSnapshot(ctx, id, name)
+	if err != nil {
+		return err
+	}
+	var never int64
+	return api.UpdateIsiSnapshot(ctx, c.API, snapshot.ID, &api.IsiUpdateSnapshotReq{Expires: &never})
+}
+
+// SetSnapshotAlias creates an alias with the given name for the snapshot matching id or name.
+func (c *Client) SetSnapshotAlias(
+	ctx context.Context, id int64, name, alias string,
+) error {
+	if alias == "" {
+		return errors.New("no alias name set")
+	}
+	snapshot, err := c.getExistingSnapshot(ctx, id, name)
+	if err != nil {
+		return err
+	}
+	return api.UpdateIsiSnapshot(ctx, c.API, snapshot.ID, &api.IsiUpdateSnapshotReq{Alias: alias})
+}
+
+// CreateSnapshotLock locks a snapshot so that it cannot be deleted until the lock is removed or expires.
+// A zero expires time creates a lock that never expires.
+func (c *Client) CreateSnapshotLock(
+	ctx context.Context, snapshotID int64, comment string, expires time.Time,
+) (SnapshotLock, error) {
+	req := &api.IsiSnapshotLockReq{Comment: comment}
+	if !expires.IsZero() {
+		epoch := expires.Unix()
+		req.Expires = &epoch
+	}
+	lockID, err := api.CreateIsiSnapshotLock(ctx, c.API, snapshotID, req)
+	if err != nil {
+		return nil, err
+	}
+	return api.GetIsiSnapshotLock(ctx, c.API, snapshotID, lockID)
+}
+
+// GetSnapshotLocks returns all locks held on a snapshot.
+func (c *Client) GetSnapshotLocks(
+	ctx context.Context, snapshotID int64,
+) (SnapshotLockList, error) {
+	return api.GetIsiSnapshotLocks(ctx, c.API, snapshotID)
+}
+
+// GetSnapshotLock returns an individual lock held on a snapshot.
+func (c *Client) GetSnapshotLock(
+	ctx context.Context, snapshotID, lockID int64,
+) (SnapshotLock, error) {
+	return api.GetIsiSnapshotLock(ctx, c.API, snapshotID, lockID)
+}
+
+// SetSnapshotLockExpiry changes the time at which a snapshot lock expires.
+// A lock cannot be made permanent once created, so expires must be set.
+func (c *Client) SetSnapshotLockExpiry(
+	ctx context.Context, snapshotID, lockID int64, expires time.Time,
+) error {
+	if expires.IsZero() {
+		return errors.New("no lock expiry time set")
+	}
+	epoch := expires.Unix()
+	return api.UpdateIsiSnapshotLock(ctx, c.API, snapshotID, lockID, &api.IsiSnapshotLockReq{Expires: &epoch})
+}
+
+// RemoveSnapshotLock releases a lock held on a snapshot.
+func (c *Client) RemoveSnapshotLock(
+	ctx context.Context, snapshotID, lockID int64,
+) error {
+	return api.RemoveIsiSnapshotLock(ctx, c.API, snapshotID, lockID)
+}
+
+// CreateSnapshotAlias creates an alias pointing at the target snapshot name or ID.
+func (c *Client) CreateSnapshotAlias(
+	ctx context.Context, name, target string,
+) (SnapshotAlias, error) {
+	_, err := api.CreateIsiSnapshotAlias(ctx, c.API, name, target)
+	if err != nil {
+		return nil, err
+	}
+	return api.GetIsiSnapshotAlias(ctx, c.API, name)
+}
+
+// GetSnapshotAliases returns all snapshot aliases on the cluster.
+func (c *Client) GetSnapshotAliases(ctx context.Context) (SnapshotAliasList, error) {
+	return api.GetIsiSnapshotAliases(ctx, c.API)
+}
+
+// GetSnapshotAlias returns a snapshot alias by ID or name.
+func (c *Client) GetSnapshotAlias(
+	ctx context.Context, identity string,
+) (SnapshotAlias, error) {
+	return api.GetIsiSnapshotAlias(ctx, c.API, identity)
+}
+
+// RetargetSnapshotAlias points an existing alias at a different snapshot name or ID.
+func (c *Client) RetargetSnapshotAlias(
+	ctx context.Context, identity, target string,
+) error {
+	if target == "" {
+		return errors.New("no alias target set")
+	}
+	return api.UpdateIsiSnapshotAlias(ctx, c.API, identity, &api.IsiSnapshotAliasReq{Target: target})
+}
+
+// RemoveSnapshotAlias deletes a snapshot alias by ID or name.
+func (c *Client) RemoveSnapshotAlias(
+	ctx context.Context, identity string,
+) error {
+	return api.RemoveIsiSnapshotAlias(ctx, c.API, identity)
+}
+
+// RestoreSnapshotOptions are options for reverting a path to a snapshot in place.
+type RestoreSnapshotOptions struct {
+	// CreateDomain runs a DomainMark job to create the SnapRevert domain for
+	// the snapshot path before reverting, even if the path may already have
+	// one. Without it the domain is only created when the path has none.
+	CreateDomain bool
+
+	// OnProgress is called with the running job each time it is polled.
+	OnProgress func(Job)
+}
+
+// isSnapRevertDomainMissing returns true if err is the refusal of the job
+// engine to start a SnapRevert job. The job is only started for an existing
+// snapshot, so the cause left for a bad request is that the path of the
+// snapshot is not in a SnapRevert domain.
+func isSnapRevertDomainMissing(err error) bool {
+	return isBadRequestError(err)
+}
+
+// CreateSnapRevertDomain creates a SnapRevert domain rooted at path and waits
+// for the DomainMark job to finish.
+func (c *Client) CreateSnapRevertDomain(
+	ctx context.Context, path string, onProgress func(Job),
+) error {
+	job, err := c.StartJob(ctx, &api.IsiJobReq{
+		Type: JobTypeDomainMark,
+		DomainmarkParams: &api.IsiJobDomainmarkParams{
+			Root:   path,
+			DmType: JobTypeSnapRevert,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = c.WaitForJob(ctx, job.ID, onProgress)
+	return err
+}
+
+// RestoreSnapshot reverts the path of the snapshot matching id, or failing
+// that, the snapshot matching name, to its state in the snapshot. Unlike
+// CopySnapshot the data is not copied, the path is rolled back in place by a
+// SnapRevert job. The call blocks until the job finishes and returns it.
+//
+// The SnapRevert domain the job requires is created if needed: OneFS has no
+// API to read the domains of a path, so when the job engine refuses to start
+// the job with a bad request, the domain is created and the job started again.
+// A job that fails after it started is not retried.
+func (c *Client) RestoreSnapshot(
+	ctx context.Context, id int64, name string, opts *RestoreSnapshotOptions,
+) (Job, error) {
+	if opts == nil {
+		opts = &RestoreSnapshotOptions{}
+	}
+	snapshot, err := c.getExistingSnapshot(ctx, id, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.CreateDomain {
+		if err := c.CreateSnapRevertDomain(ctx, snapshot.Path, opts.OnProgress); err != nil {
+			return nil, fmt.Errorf("failed to create SnapRevert domain for %s: %v", snapshot.Path, err)
+		}
+	}
+
+	return c.snapRevert(ctx, snapshot, !opts.CreateDomain, opts.OnProgress)
+}
+
+// snapRevert runs a SnapRevert job for the snapshot and waits for it. If
+// createDomain is set and the job is refused, the SnapRevert domain is
+// created and the job started once more.
+func (c *Client) snapRevert(
+	ctx context.Context, snapshot *api.IsiSnapshot, createDomain bool, onProgress func(Job),
+) (Job, error) {
+	req := &api.IsiJobReq{
+		Type: JobTypeSnapRevert,
+		SnaprevertParams: &api.IsiJobSnaprevertParams{
+			Snapid: snapshot.ID,
+		},
+	}
+	job, err := c.StartJob(ctx, req)
+	if createDomain && isSnapRevertDomainMissing(err) {
+		log.Info(ctx, "SnapRevert job for %s refused, creating its SnapRevert domain: %v", snapshot.Path, err)
+		if err := c.CreateSnapRevertDomain(ctx, snapshot.Path, onProgress); err != nil {
+			return nil, fmt.Errorf("failed to create SnapRevert domain for %s: %w", snapshot.Path, err)
+		}
+		job, err = c.StartJob(ctx, req)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return c.WaitForJob(ctx, job.ID, onProgress)
+}
+
+// CreateWritableSnapshot creates a writable snapshot of snapshot mounted at
+// dstPath. The path must not exist yet. Writable snapshots require OneFS 9.3
+// or later.
+func (c *Client) CreateWritableSnapshot(
+	ctx context.Context, snapshot Snapshot, dstPath string,
+) (WritableSnapshot, error) {
+	if snapshot == nil {
+		return nil, errors.New("no source snapshot set")
+	}
+	srcSnap := snapshot.Name
+	if srcSnap == "" {
+		srcSnap = strconv.FormatInt(snapshot.ID, 10)
+	}
+	return apiv14.CreateIsiWritableSnapshot(ctx, c.API, srcSnap, dstPath)
+}
+
+// GetWritableSnapshots returns all writable snapshots on the cluster.
+func (c *Client) GetWritableSnapshots(ctx context.Context) (WritableSnapshotList, error) {
+	return apiv14.GetIsiWritableSnapshots(ctx, c.API)
+}
+
+// GetWritableSnapshot returns the writable snapshot mounted at dstPath.
+func (c *Client) GetWritableSnapshot(
+	ctx context.Context, dstPath string,
+) (WritableSnapshot, error) {
+	return apiv14.GetIsiWritableSnapshot(ctx, c.API, dstPath)
+}
+
+// RemoveWritableSnapshot deletes the writable snapshot mounted at dstPath
+// along with any changes made to it.
+func (c *Client) RemoveWritableSnapshot(
+	ctx context.Context, dstPath string,
+) error {
+	return apiv14.RemoveIsiWritableSnapshot(ctx, c.API, dstPath)
+}
+
+// CreateAndExportWritableSnapshot creates a writable snapshot of snapshot
+// mounted at dstPath and exports that path in the given access zone. If the
+// export cannot be created the writable snapshot is removed again.
+func (c *Client) CreateAndExportWritableSnapshot(
+	ctx context.Context, snapshot Snapshot, dstPath, zone, description string,
+) (WritableSnapshot, int, error) {
+	writable, err := c.CreateWritableSnapshot(ctx, snapshot, dstPath)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	exportID, err := c.ExportPathWithZone(ctx, writable.DstPath, zone, description)
+	if err != nil {
+		if removeErr := c.RemoveWritableSnapshot(ctx, writable.DstPath); removeErr != nil {
+			log.Error(ctx, "failed to remove writable snapshot '%s' after export failure: '%v'", writable.DstPath, removeErr)
+		}
+		return nil, 0, err
+	}
+	return writable, exportID, nil
+}
+
+// GetPendingSnapshots returns the snapshots schedules will create between begin and end.
+// A zero begin or end leaves that bound to the cluster default.
+func (c *Client) GetPendingSnapshots(
+	ctx context.Context, begin, end time.Time,
+) (PendingSnapshotList, error) {
+	var beginUnix, endUnix int64
+	if !begin.IsZero() {
+		beginUnix = begin.Unix()
+	}
+	if !end.IsZero() {
+		endUnix = end.Unix()
+	}
+	return api.GetIsiPendingSnapshots(ctx, c.API, beginUnix, endUnix)
+}
+
+// GetSnapshotSettings returns the cluster wide snapshot settings.
+func (c *Client) GetSnapshotSettings(ctx context.Context) (SnapshotSettings, error) {
+	return api.GetIsiSnapshotSettings(ctx, c.API)
+}
+
+// UpdateSnapshotSettings modifies the cluster wide snapshot settings, nil fields are left unchanged.
+func (c *Client) UpdateSnapshotSettings(
+	ctx context.Context, settings *api.IsiSnapshotSettingsReq,
+) error {
+	return api.UpdateIsiSnapshotSettings(ctx, c.API, settings)
+}
+
+// snapshotDirIsRoot reports whether the .snapshot directory for isiPath is the
+// one at the root of /ifs, which OneFS controls separately from subdirectories.
+func snapshotDirIsRoot(isiPath string) bool {
+	return path.Clean(isiPath) == "/ifs"
+}
+
+// CheckSnapshotNFSAccess returns an error if the snapshot settings prevent NFS
+// clients from accessing the .snapshot directory under isiPath, which is where
+// the paths returned by GetSnapshotIsiPath live.
+func (c *Client) CheckSnapshotNFSAccess(ctx context.Context, isiPath string) error {
+	settings, err := c.GetSnapshotSettings(ctx)
+	if err != nil {
+		return err
+	}
+	if snapshotDirIsRoot(isiPath) {
+		if !settings.NfsRootAccessible {
+			return fmt.Errorf("%s is not accessible over NFS: nfs_root_accessible is disabled", path.Join(isiPath, snapShot))
+		}
+		return nil
+	}
+	if !settings.NfsSubdirAccessible {
+		return fmt.Errorf("%s is not accessible over NFS: nfs_subdir_accessible is disabled", path.Join(isiPath, snapShot))
+	}
+	return nil
+}
+
+// EnsureSnapshotNFSAccess enables NFS access to the .snapshot directory under
+// isiPath if the snapshot settings currently prevent it. When visible is true the
+// .snapshot directory is also made to appear in directory listings of the /ifs root.
+func (c *Client) EnsureSnapshotNFSAccess(ctx context.Context, isiPath string, visible bool) error {
+	settings, err := c.GetSnapshotSettings(ctx)
+	if err != nil {
+		return err
+	}
+	enabled := true
+	req := &api.IsiSnapshotSettingsReq{}
+	changed := false
+	if snapshotDirIsRoot(isiPath) {
+		if !settings.NfsRootAccessible {
+			req.NfsRootAccessible = &enabled
+			changed = true
+		}
+		if visible && !settings.NfsRootVisible {
+			req.NfsRootVisible = &enabled
+			changed = true
+		}
+	} else if !settings.NfsSubdirAccessible {
+		req.NfsSubdirAccessible = &enabled
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	log.Info(ctx, "enabling NFS access to snapshots under '%s'", isiPath)
+	return c.UpdateSnapshotSettings(ctx, req)
+}
@@ -20,8 +20,12 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/dell/goisilon/api"
 	apiv1 "github.com/dell/goisilon/api/v1"
+	apiv14 "github.com/dell/goisilon/api/v14"
+	apiv2 "github.com/dell/goisilon/api/v2"
 	"github.com/dell/goisilon/mocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -497,3 +501,205 @@ func TestGetSnapshotIsiPath(t *testing.T) {
 	_, err = client.GetSnapshotIsiPath(context.Background(), isiPath, "test-snapshot", accessZone)
 	assert.Nil(t, err)
 }
+
+func TestUpdateSnapshotProperties(t *testing.T) {
+	client := &Client{API: new(mocks.Client)}
+	ctx := context.Background()
+
+	client.API.(*mocks.Client).On("Get", ctx, "platform/1/snapshot/snapshots/7", "", mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(**apiv1.GetIsiSnapshotsResp)
+		*resp = &apiv1.GetIsiSnapshotsResp{SnapshotList: []*apiv1.IsiSnapshot{{ID: 7, Name: "snap"}}}
+	})
+
+	expires := time.Unix(1700000000, 0)
+	client.API.(*mocks.Client).On("Put", ctx, "platform/1/snapshot/snapshots/7", "", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).Run(func(args mock.Arguments) {
+		req := args.Get(5).(*apiv1.IsiUpdateSnapshotReq)
+		switch {
+		case req.Name != "":
+			assert.Equal(t, "renamed", req.Name)
+		case req.Alias != "":
+			assert.Equal(t, "latest", req.Alias)
+		case *req.Expires != 0:
+			assert.Equal(t, expires.Unix(), *req.Expires)
+		}
+	})
+
+	assert.Nil(t, client.RenameSnapshot(ctx, 7, "", "renamed"))
+	assert.Nil(t, client.SetSnapshotExpiry(ctx, 7, "", expires))
+	assert.Nil(t, client.SetSnapshotExpiry(ctx, 7, "", time.Time{}))
+	assert.Nil(t, client.SetSnapshotAlias(ctx, 7, "", "latest"))
+	client.API.(*mocks.Client).AssertNumberOfCalls(t, "Put", 4)
+
+	assert.NotNil(t, client.RenameSnapshot(ctx, 7, "", ""))
+	assert.NotNil(t, client.SetSnapshotAlias(ctx, 7, "", ""))
+
+	client.API.(*mocks.Client).On("Get", ctx, "platform/1/snapshot/snapshots/8", "", mock.Anything, mock.Anything, mock.Anything).
+		Return(fmt.Errorf("not found"))
+	err := client.ClearSnapshotExpiry(ctx, 8, "")
+	assert.EqualError(t, err, "not found")
+}
+
+func TestSnapshotLocks(t *testing.T) {
+	client := &Client{API: new(mocks.Client)}
+	ctx := context.Background()
+
+	client.API.(*mocks.Client).On("Post", ctx, "platform/1/snapshot/snapshots/7/locks", "", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).Once()
+	client.API.(*mocks.Client).On("Get", ctx, "platform/1/snapshot/snapshots/7/locks", "0", mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*apiv1.IsiSnapshotLocksResp)
+		resp.Locks = []*apiv1.IsiSnapshotLock{{Comment: "streaming"}}
+	}).Once()
+	lock, err := client.CreateSnapshotLock(ctx, 7, "streaming", time.Now().Add(time.Hour))
+	assert.Nil(t, err)
+	assert.Equal(t, "streaming", lock.Comment)
+
+	client.API.(*mocks.Client).On("Get", ctx, "platform/1/snapshot/snapshots/7/locks", "", mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).Once()
+	locks, err := client.GetSnapshotLocks(ctx, 7)
+	assert.Nil(t, err)
+	assert.Len(t, locks, 0)
+
+	client.API.(*mocks.Client).On("Put", ctx, "platform/1/snapshot/snapshots/7/locks", "1", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).Once()
+	assert.Nil(t, client.SetSnapshotLockExpiry(ctx, 7, 1, time.Now().Add(time.Hour)))
+	assert.EqualError(t, client.SetSnapshotLockExpiry(ctx, 7, 1, time.Time{}), "no lock expiry time set")
+
+	client.API.(*mocks.Client).On("Delete", ctx, "platform/1/snapshot/snapshots/7/locks", "1", mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).Once()
+	assert.Nil(t, client.RemoveSnapshotLock(ctx, 7, 1))
+
+	client.API.(*mocks.Client).On("Post", anyArgs...).Return(fmt.Errorf("snapshot not found")).Once()
+	_, err = client.CreateSnapshotLock(ctx, 9, "", time.Time{})
+	assert.NotNil(t, err)
+}
+
+func TestSnapshotAliases(t *testing.T) {
+	client := &Client{API: new(mocks.Client)}
+	ctx := context.Background()
+
+	client.API.(*mocks.Client).On("Post", ctx, "platform/1/snapshot/aliases", "", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).Once()
+	client.API.(*mocks.Client).On("Get", ctx, "platform/1/snapshot/aliases", "latest", mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*apiv1.IsiSnapshotAliasesResp)
+		resp.Aliases = []*apiv1.IsiSnapshotAlias{{Name: "latest", TargetName: "snap"}}
+	}).Once()
+	alias, err := client.CreateSnapshotAlias(ctx, "latest", "snap")
+	assert.Nil(t, err)
+	assert.Equal(t, "snap", alias.TargetName)
+
+	client.API.(*mocks.Client).On("Get", ctx, "platform/1/snapshot/aliases", "", mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).Once()
+	_, err = client.GetSnapshotAliases(ctx)
+	assert.Nil(t, err)
+
+	assert.NotNil(t, client.RetargetSnapshotAlias(ctx, "latest", ""))
+	client.API.(*mocks.Client).On("Put", ctx, "platform/1/snapshot/aliases", "latest", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).Once()
+	assert.Nil(t, client.RetargetSnapshotAlias(ctx, "latest", "snap2"))
+
+	client.API.(*mocks.Client).On("Delete", ctx, "platform/1/snapshot/aliases", "latest", mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).Once()
+	assert.Nil(t, client.RemoveSnapshotAlias(ctx, "latest"))
+}
+
+func TestWritableSnapshots(t *testing.T) {
+	client := &Client{API: new(mocks.Client)}
+	ctx := context.Background()
+	snapshot := &apiv1.IsiSnapshot{ID: 7, Name: "snap"}
+
+	_, err := client.CreateWritableSnapshot(ctx, nil, "/ifs/data/clone")
+	assert.EqualError(t, err, "no source snapshot set")
+
+	client.API.(*mocks.Client).On("Post", ctx, "platform/14/snapshot/writable", "", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(6).(*apiv14.IsiWritableSnapshot)
+		resp.DstPath = "/ifs/data/clone"
+		resp.SnapID = 7
+	}).Times(3)
+	client.API.(*mocks.Client).On("Post", ctx, "platform/2/protocols/nfs/exports", "", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(6).(*apiv2.Export)
+		resp.ID = 12
+	}).Once()
+
+	writable, exportID, err := client.CreateAndExportWritableSnapshot(ctx, snapshot, "/ifs/data/clone", "System", "dev clone")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(7), writable.SnapID)
+	assert.Equal(t, 12, exportID)
+
+	// the writable snapshot is removed if it cannot be exported
+	client.API.(*mocks.Client).On("Post", ctx, "platform/2/protocols/nfs/exports", "", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(fmt.Errorf("export failed")).Once()
+	client.API.(*mocks.Client).On("Delete", ctx, "platform/14/snapshot/writable", "ifs/data/clone", mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).Once()
+	_, _, err = client.CreateAndExportWritableSnapshot(ctx, snapshot, "/ifs/data/clone", "System", "")
+	assert.EqualError(t, err, "export failed")
+
+	// snapshots without a name are referenced by ID
+	writable, err = client.CreateWritableSnapshot(ctx, &apiv1.IsiSnapshot{ID: 7}, "/ifs/data/clone")
+	assert.Nil(t, err)
+	assert.Equal(t, "/ifs/data/clone", writable.DstPath)
+
+	client.API.(*mocks.Client).On("Get", ctx, "platform/14/snapshot/writable", "", mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).Once()
+	_, err = client.GetWritableSnapshots(ctx)
+	assert.Nil(t, err)
+	client.API.(*mocks.Client).AssertExpectations(t)
+}
+
+func TestGetPendingSnapshots(t *testing.T) {
+	client := &Client{API: new(mocks.Client)}
+	ctx := context.Background()
+
+	begin := time.Unix(100, 0)
+	client.API.(*mocks.Client).On("Get", mock.Anything, "platform/1/snapshot/pending", "", api.OrderedValues{
+		{[]byte("begin"), []byte("100")},
+	}, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*apiv1.IsiPendingSnapshotsResp)
+		resp.Pending = []*apiv1.IsiPendingSnapshot{{ID: 1, Path: "/ifs/data", Schedule: "hourly"}}
+	}).Once()
+	pending, err := client.GetPendingSnapshots(ctx, begin, time.Time{})
+	assert.NoError(t, err)
+	assert.Len(t, pending, 1)
+	assert.Equal(t, "hourly", pending[0].Schedule)
+}
+
+func mockSnapshotSettings(c *mocks.Client, settings apiv1.IsiSnapshotSettings) *mock.Call {
+	return c.On("Get", mock.Anything, "platform/1/snapshot/settings", "", mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(*apiv1.IsiSnapshotSettingsResp)
+		resp.Settings = &settings
+	})
+}
+
+func TestCheckSnapshotNFSAccess(t *testing.T) {
+	client := &Client{API: new(mocks.Client)}
+	ctx := context.Background()
+
+	mockSnapshotSettings(client.API.(*mocks.Client), apiv1.IsiSnapshotSettings{NfsRootAccessible: true}).Twice()
+	assert.NoError(t, client.CheckSnapshotNFSAccess(ctx, "/ifs"))
+	assert.ErrorContains(t, client.CheckSnapshotNFSAccess(ctx, "/ifs/data/zone1"), "nfs_subdir_accessible is disabled")
+}
+
+func TestEnsureSnapshotNFSAccess(t *testing.T) {
+	client := &Client{API: new(mocks.Client)}
+	ctx := context.Background()
+	enabled := true
+
+	mockSnapshotSettings(client.API.(*mocks.Client), apiv1.IsiSnapshotSettings{NfsRootAccessible: true}).Times(3)
+	client.API.(*mocks.Client).On("Put", mock.Anything, "platform/1/snapshot/settings", "", mock.Anything, mock.Anything,
+		&apiv1.IsiSnapshotSettingsReq{NfsSubdirAccessible: &enabled}, mock.Anything).Return(nil).Once()
+	assert.NoError(t, client.EnsureSnapshotNFSAccess(ctx, "/ifs/data/zone1", false))
+
+	client.API.(*mocks.Client).On("Put", mock.Anything, "platform/1/snapshot/settings", "", mock.Anything, mock.Anything,
+		&apiv1.IsiSnapshotSettingsReq{NfsRootVisible: &enabled}, mock.Anything).Return(nil).Once()
+	assert.NoError(t, client.EnsureSnapshotNFSAccess(ctx, "/ifs", true))
+
+	// nothing to change
+	assert.NoError(t, client.EnsureSnapshotNFSAccess(ctx, "/ifs", false))
+	client.API.(*mocks.Client).AssertNumberOfCalls(t, "Put", 2)
+}
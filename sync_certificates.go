@@ -0,0 +1,208 @@
+/*
+Copyright (c) 2025 Dell Inc, or its subsidiaries.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package goisilon
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	apiv1 "github.com/dell/goisilon/api/v1"
+	apiv11 "github.com/dell/goisilon/api/v11"
+	apiv2 "github.com/dell/goisilon/api/v2"
+)
+
+// certificateFileMode keeps uploaded certificates and keys private to the owner
+const certificateFileMode = apiv2.FileMode(0o600)
+
+// parseCertificatePEM returns the first certificate in certPEM.
+func parseCertificatePEM(certPEM []byte) (*x509.Certificate, error) {
+	for rest := certPEM; len(rest) > 0; {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type == "CERTIFICATE" {
+			return x509.ParseCertificate(block.Bytes)
+		}
+	}
+	return nil, errors.New("no PEM encoded certificate found")
+}
+
+// SyncCertificateSource locates the certificate and key imported by
+// ImportSyncCertificate. Each of them is either a file already present on the
+// cluster or PEM data, which is staged to a file in StagingDir for the import.
+type SyncCertificateSource struct {
+	// CertificatePath is the absolute path of a PEM certificate file on the
+	// cluster, used instead of staging CertificatePEM.
+	CertificatePath string
+	CertificatePEM  []byte
+	// KeyPath is the absolute path of the PEM key file of a server
+	// certificate on the cluster, used instead of staging KeyPEM.
+	KeyPath     string
+	KeyPEM      []byte
+	KeyPassword string
+	// StagingDir is the absolute path of an existing directory on the cluster
+	// the PEM data is written to with mode 0600 and removed from once imported.
+	// It must not be exported, snapshotted or replicated, as a copy of a staged
+	// key would outlive its removal, and cannot be below the volumes path.
+	StagingDir string
+}
+
+// stageCertificateFile writes data to a new file in dir and returns its path.
+func (c *Client) stageCertificateFile(ctx context.Context, dir string, data []byte) (string, error) {
+	suffix := make([]byte, 8)
+	if _, err := rand.Read(suffix); err != nil {
+		return "", err
+	}
+	isiPath := path.Join(dir, ".synciq-"+hex.EncodeToString(suffix)+".pem")
+	err := c.UploadFile(ctx, isiPath, bytes.NewReader(data), int64(len(data)), &UploadOptions{Mode: certificateFileMode})
+	if err != nil {
+		return "", err
+	}
+	return isiPath, nil
+}
+
+// validateStagingDir returns an error if dir cannot hold staged certificate files.
+func (c *Client) validateStagingDir(dir string) error {
+	if dir == "" {
+		return errors.New("no staging directory set for the PEM data")
+	}
+	if !path.IsAbs(dir) {
+		return fmt.Errorf("staging directory '%s' is not an absolute path", dir)
+	}
+	dir = path.Clean(dir)
+	volumes := path.Clean(c.API.VolumesPath())
+	if dir == volumes || strings.HasPrefix(dir, volumes+"/") {
+		return fmt.Errorf("staging directory '%s' must not be below the volumes path %s", dir, volumes)
+	}
+	return nil
+}
+
+// ImportSyncCertificate imports a SyncIQ certificate and, for a server
+// certificate, its key, and returns the ID of the certificate. PEM data in src
+// is staged to files in src.StagingDir, which are removed once imported.
+// Failing to remove a staged file is an error, returned along with the ID if
+// the certificate was imported.
+func (c *Client) ImportSyncCertificate(
+	ctx context.Context, kind apiv11.SyncCertificateKind, name, description string, src *SyncCertificateSource,
+) (id string, err error) {
+	if src == nil || (src.CertificatePath == "" && len(src.CertificatePEM) == 0) {
+		return "", errors.New("no certificate set")
+	}
+	server := kind == apiv11.ServerCertificate
+	if server && src.KeyPath == "" && len(src.KeyPEM) == 0 {
+		return "", errors.New("a server certificate requires a key")
+	}
+	if src.CertificatePath == "" {
+		cert, err := parseCertificatePEM(src.CertificatePEM)
+		if err != nil {
+			return "", err
+		}
+		if time.Now().After(cert.NotAfter) {
+			return "", fmt.Errorf("certificate %s expired on %s", cert.Subject, cert.NotAfter)
+		}
+	}
+	if src.CertificatePath == "" || (server && src.KeyPath == "") {
+		if err := c.validateStagingDir(src.StagingDir); err != nil {
+			return "", err
+		}
+	}
+
+	var staged []string
+	defer func() {
+		for _, isiPath := range staged {
+			if removeErr := apiv1.DeleteIsiNamespaceEntry(ctx, c.API, isiPath, false); removeErr != nil {
+				err = errors.Join(err, fmt.Errorf("failed to remove staged certificate file '%s': %w", isiPath, removeErr))
+			}
+		}
+	}()
+	stage := func(filePath string, data []byte) (string, error) {
+		if filePath != "" {
+			return filePath, nil
+		}
+		isiPath, err := c.stageCertificateFile(ctx, src.StagingDir, data)
+		if err != nil {
+			return "", err
+		}
+		staged = append(staged, isiPath)
+		return isiPath, nil
+	}
+
+	req := &apiv11.SyncCertificateImport{Name: name, Description: description}
+	if req.CertificatePath, err = stage(src.CertificatePath, src.CertificatePEM); err != nil {
+		return "", err
+	}
+	if server {
+		if req.CertificateKeyPath, err = stage(src.KeyPath, src.KeyPEM); err != nil {
+			return "", err
+		}
+		req.CertificateKeyPassword = src.KeyPassword
+	}
+	return apiv11.ImportSyncCertificate(ctx, c.API, kind, req)
+}
+
+// GetSyncCertificates returns the SyncIQ certificates of the given kind.
+func (c *Client) GetSyncCertificates(ctx context.Context, kind apiv11.SyncCertificateKind) ([]apiv11.SyncCertificate, error) {
+	return apiv11.GetSyncCertificates(ctx, c.API, kind)
+}
+
+// GetSyncCertificate returns a SyncIQ certificate of the given kind by ID.
+func (c *Client) GetSyncCertificate(ctx context.Context, kind apiv11.SyncCertificateKind, id string) (*apiv11.SyncCertificate, error) {
+	return apiv11.GetSyncCertificate(ctx, c.API, kind, id)
+}
+
+// DeleteSyncCertificate deletes a SyncIQ certificate of the given kind.
+func (c *Client) DeleteSyncCertificate(ctx context.Context, kind apiv11.SyncCertificateKind, id string) error {
+	return apiv11.DeleteSyncCertificate(ctx, c.API, kind, id)
+}
+
+// VerifySyncCertificate returns an error if a SyncIQ certificate has expired
+// or will expire within minValidity.
+func (c *Client) VerifySyncCertificate(
+	ctx context.Context, kind apiv11.SyncCertificateKind, id string, minValidity time.Duration,
+) error {
+	cert, err := c.GetSyncCertificate(ctx, kind, id)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	if cert.ExpiresBefore(now) {
+		return fmt.Errorf("%s certificate %s expired on %s", kind, id, time.Unix(cert.NotAfter, 0))
+	}
+	if cert.ExpiresBefore(now.Add(minValidity)) {
+		return fmt.Errorf("%s certificate %s expires on %s", kind, id, time.Unix(cert.NotAfter, 0))
+	}
+	return nil
+}
+
+// SetSyncClusterIdentity makes the server certificate with the given ID the
+// certificate this cluster presents to its SyncIQ peers.
+func (c *Client) SetSyncClusterIdentity(ctx context.Context, certificateID string) error {
+	if _, err := c.GetSyncCertificate(ctx, apiv11.ServerCertificate, certificateID); err != nil {
+		return err
+	}
+	return c.UpdateSyncSettings(ctx, &apiv11.SyncSettingsUpdate{ClusterCertificateID: &certificateID})
+}
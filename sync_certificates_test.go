@@ -0,0 +1,144 @@
+/*
+Copyright (c) 2025 Dell Inc, or its subsidiaries.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package goisilon
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	apiv11 "github.com/dell/goisilon/api/v11"
+	"github.com/dell/goisilon/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func testCertificatePEM(t *testing.T, notAfter time.Time) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "cluster"},
+		NotBefore:    notAfter.Add(-365 * 24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func TestImportSyncCertificate(t *testing.T) {
+	ctx := context.Background()
+	client := &Client{API: new(mocks.Client)}
+	m := client.API.(*mocks.Client)
+	m.On("VolumesPath").Return("/ifs/volumes")
+
+	_, err := client.ImportSyncCertificate(ctx, apiv11.PeerCertificate, "peer", "", nil)
+	assert.EqualError(t, err, "no certificate set")
+
+	_, err = client.ImportSyncCertificate(ctx, apiv11.PeerCertificate, "peer", "", &SyncCertificateSource{CertificatePEM: []byte("garbage")})
+	assert.ErrorContains(t, err, "no PEM encoded certificate found")
+
+	_, err = client.ImportSyncCertificate(ctx, apiv11.PeerCertificate, "peer", "",
+		&SyncCertificateSource{CertificatePEM: testCertificatePEM(t, time.Now().Add(-time.Hour))})
+	assert.ErrorContains(t, err, "expired")
+
+	certPEM := testCertificatePEM(t, time.Now().Add(24*time.Hour))
+	_, err = client.ImportSyncCertificate(ctx, apiv11.ServerCertificate, "server", "", &SyncCertificateSource{CertificatePEM: certPEM})
+	assert.ErrorContains(t, err, "requires a key")
+
+	_, err = client.ImportSyncCertificate(ctx, apiv11.PeerCertificate, "peer", "", &SyncCertificateSource{CertificatePEM: certPEM})
+	assert.EqualError(t, err, "no staging directory set for the PEM data")
+
+	_, err = client.ImportSyncCertificate(ctx, apiv11.PeerCertificate, "peer", "",
+		&SyncCertificateSource{CertificatePEM: certPEM, StagingDir: "/ifs/volumes/certs"})
+	assert.ErrorContains(t, err, "must not be below the volumes path")
+
+	var uploaded []string
+	m.On("Put", ctx, "namespace", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		assert.Equal(t, "0600", args.Get(4).(map[string]string)["x-isi-ifs-access-control"])
+		uploaded = append(uploaded, "/"+args.String(2))
+	}).Twice()
+	m.On("Post", ctx, "/platform/11/sync/certificates/server/", "", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		req := args.Get(5).(*apiv11.SyncCertificateImport)
+		assert.Equal(t, uploaded[0], req.CertificatePath)
+		assert.Equal(t, uploaded[1], req.CertificateKeyPath)
+		assert.Equal(t, "secret", req.CertificateKeyPassword)
+		args.Get(6).(*apiv11.SyncCertificate).ID = "abc"
+	}).Once()
+	m.On("Delete", ctx, "namespace", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	m.On("Delete", ctx, "namespace", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("busy")).Once()
+
+	id, err := client.ImportSyncCertificate(ctx, apiv11.ServerCertificate, "server", "", &SyncCertificateSource{
+		CertificatePEM: certPEM,
+		KeyPEM:         []byte("key"),
+		KeyPassword:    "secret",
+		StagingDir:     "/ifs/.staging",
+	})
+	assert.Equal(t, "abc", id)
+	assert.ErrorContains(t, err, "failed to remove staged certificate file")
+	assert.ErrorContains(t, err, "busy")
+	assert.Len(t, uploaded, 2)
+	for _, isiPath := range uploaded {
+		assert.True(t, strings.HasPrefix(isiPath, "/ifs/.staging/.synciq-"))
+	}
+	m.AssertNumberOfCalls(t, "Delete", 2)
+
+	// files already on the cluster are imported as they are
+	m.On("Post", ctx, "/platform/11/sync/certificates/peer/", "", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		assert.Equal(t, "/ifs/.certs/peer.pem", args.Get(5).(*apiv11.SyncCertificateImport).CertificatePath)
+		args.Get(6).(*apiv11.SyncCertificate).ID = "def"
+	}).Once()
+	id, err = client.ImportSyncCertificate(ctx, apiv11.PeerCertificate, "peer", "", &SyncCertificateSource{CertificatePath: "/ifs/.certs/peer.pem"})
+	assert.NoError(t, err)
+	assert.Equal(t, "def", id)
+	m.AssertNumberOfCalls(t, "Put", 2)
+}
+
+func TestVerifySyncCertificate(t *testing.T) {
+	ctx := context.Background()
+	client := &Client{API: new(mocks.Client)}
+
+	notAfter := time.Now().Add(48 * time.Hour).Unix()
+	client.API.(*mocks.Client).On("Get", ctx, "/platform/11/sync/certificates/peer/", "abc", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(**apiv11.SyncCertificates)
+		*resp = &apiv11.SyncCertificates{Certificates: []apiv11.SyncCertificate{{ID: "abc", NotAfter: notAfter}}}
+	}).Twice()
+	assert.NoError(t, client.VerifySyncCertificate(ctx, apiv11.PeerCertificate, "abc", 24*time.Hour))
+	assert.ErrorContains(t, client.VerifySyncCertificate(ctx, apiv11.PeerCertificate, "abc", 72*time.Hour), "peer certificate abc expires on")
+}
+
+func TestSetSyncClusterIdentity(t *testing.T) {
+	ctx := context.Background()
+	client := &Client{API: new(mocks.Client)}
+
+	client.API.(*mocks.Client).On("Get", ctx, "/platform/11/sync/certificates/server/", "abc", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(**apiv11.SyncCertificates)
+		*resp = &apiv11.SyncCertificates{Certificates: []apiv11.SyncCertificate{{ID: "abc"}}}
+	}).Once()
+	id := "abc"
+	client.API.(*mocks.Client).On("Put", ctx, "/platform/11/sync/settings", "", mock.Anything, mock.Anything, &apiv11.SyncSettingsUpdate{ClusterCertificateID: &id}, mock.Anything).Return(nil).Once()
+	assert.NoError(t, client.SetSyncClusterIdentity(ctx, "abc"))
+}
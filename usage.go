@@ -0,0 +1,272 @@
+/*
+Copyright (c) 2025 Dell Inc, or its subsidiaries.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package goisilon
+
+import (
+	"container/heap"
+	"context"
+	"errors"
+	"io/fs"
+	"path"
+	"slices"
+	"sort"
+	"time"
+
+	apiv1 "github.com/dell/goisilon/api/v1"
+	apiv3 "github.com/dell/goisilon/api/v3"
+)
+
+// TreeSummarySource is where the usage of a TreeSummary comes from.
+type TreeSummarySource string
+
+// Sources of a TreeSummary.
+const (
+	// TreeSummarySourceQuota is the usage of a directory quota on the path.
+	TreeSummarySourceQuota TreeSummarySource = "quota"
+	// TreeSummarySourceFSA is the result of a File System Analytics job.
+	TreeSummarySourceFSA TreeSummarySource = "fsa"
+	// TreeSummarySourceWalk is a walk of the tree.
+	TreeSummarySourceWalk TreeSummarySource = "walk"
+)
+
+// FileUsage is a file reported by a TreeSummary.
+type FileUsage struct {
+	Path    string
+	Size    int64
+	ModTime time.Time
+}
+
+// AgeBucket counts the files of a TreeSummary last modified within an age range.
+type AgeBucket struct {
+	// MaxAge is the upper bound of the ages in the bucket, 0 for the last
+	// bucket which holds the files older than all bounds.
+	MaxAge time.Duration
+	Files  int64
+	Size   int64
+}
+
+// TreeSummary is the usage of a directory tree.
+type TreeSummary struct {
+	Path   string
+	Source TreeSummarySource
+	// AsOf is when the usage was computed.
+	AsOf time.Time
+	// Size is the logical size of the tree in bytes.
+	Size int64
+	// Inodes is the number of entries in the tree.
+	Inodes int64
+	// Files and Dirs count the files and directories below the path, they
+	// are not set by TreeSummarySourceQuota.
+	Files int64
+	Dirs  int64
+	// Largest are the largest files, largest first, and Ages the age
+	// histogram of the files. Both are only set by TreeSummarySourceWalk.
+	Largest []FileUsage
+	Ages    []AgeBucket
+	// Truncated is set when the walk stopped at TreeSummaryOptions.MaxEntries.
+	Truncated bool
+}
+
+// TreeSummaryOptions configures SummarizeTree.
+type TreeSummaryOptions struct {
+	// Largest is the number of largest files to report.
+	Largest int
+	// AgeBuckets are the upper bounds of the age histogram, e.g. 30, 90 and
+	// 365 days. The histogram has a last bucket for older files.
+	AgeBuckets []time.Duration
+	// MaxFSAAge ignores FSA results older than it, 0 accepts any result.
+	MaxFSAAge time.Duration
+	// SkipQuota and SkipFSA force the walk.
+	SkipQuota bool
+	SkipFSA   bool
+	// Workers is the number of directories listed concurrently by the walk,
+	// it defaults to ConcurrentHTTPConnections.
+	Workers int
+	// MaxEntries stops the walk after so many entries, 0 means no limit.
+	MaxEntries int64
+}
+
+// SummarizeTree returns the usage of the directory tree at the absolute path
+// isiPath. The usage of a directory quota on the path is preferred, then the
+// latest File System Analytics result, and else the tree is walked. Largest
+// files and age histograms require the walk.
+func (c *Client) SummarizeTree(ctx context.Context, isiPath string, opts *TreeSummaryOptions) (*TreeSummary, error) {
+	if opts == nil {
+		opts = &TreeSummaryOptions{}
+	}
+	for _, bound := range opts.AgeBuckets {
+		if bound <= 0 {
+			return nil, errors.New("age bucket bounds must be positive")
+		}
+	}
+	isiPath = path.Clean(isiPath)
+	walk := opts.Largest > 0 || len(opts.AgeBuckets) > 0
+
+	if !walk && !opts.SkipQuota {
+		if s := c.quotaTreeSummary(ctx, isiPath); s != nil {
+			return s, nil
+		}
+	}
+	if !walk && !opts.SkipFSA {
+		if s := c.fsaTreeSummary(ctx, isiPath, opts.MaxFSAAge); s != nil {
+			return s, nil
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return c.walkTreeSummary(ctx, isiPath, opts)
+}
+
+// quotaTreeSummary returns the usage of a ready directory quota on isiPath
+// that does not count snapshot data, or nil.
+func (c *Client) quotaTreeSummary(ctx context.Context, isiPath string) *TreeSummary {
+	quotas, err := apiv1.GetIsiQuotasByPath(ctx, c.API, isiPath, "directory")
+	if err != nil {
+		return nil
+	}
+	for _, quota := range quotas {
+		if quota.Path != isiPath || quota.Type != "directory" || !quota.Ready || quota.IncludeSnapshots {
+			continue
+		}
+		return &TreeSummary{
+			Path:   isiPath,
+			Source: TreeSummarySourceQuota,
+			AsOf:   time.Now(),
+			Size:   quota.Usage.Logical,
+			Inodes: quota.Usage.Inodes,
+		}
+	}
+	return nil
+}
+
+// fsaTreeSummary returns the usage of isiPath in the latest FSA result, or nil.
+func (c *Client) fsaTreeSummary(ctx context.Context, isiPath string, maxAge time.Duration) *TreeSummary {
+	if path.Dir(isiPath) == "/" {
+		return nil
+	}
+	results, err := apiv3.GetIsiFsaResults(ctx, c.API)
+	if err != nil {
+		return nil
+	}
+	var latest *apiv3.IsiFsaResult
+	for _, r := range results {
+		if r.EndTime > 0 && (latest == nil || r.EndTime > latest.EndTime) {
+			latest = r
+		}
+	}
+	if latest == nil {
+		return nil
+	}
+	asOf := time.Unix(latest.EndTime, 0)
+	if maxAge > 0 && time.Since(asOf) > maxAge {
+		return nil
+	}
+	dir, err := apiv3.GetIsiFsaDirectory(ctx, c.API, latest.ID, isiPath)
+	if err != nil {
+		return nil
+	}
+	return &TreeSummary{
+		Path:   isiPath,
+		Source: TreeSummarySourceFSA,
+		AsOf:   asOf,
+		Size:   dir.LogicalSize,
+		Inodes: dir.FileCount + dir.DirCount + dir.OtherCount,
+		Files:  dir.FileCount,
+		Dirs:   dir.DirCount,
+	}
+}
+
+// fileUsageHeap is a min-heap of files by size.
+type fileUsageHeap []FileUsage
+
+func (h fileUsageHeap) Len() int           { return len(h) }
+func (h fileUsageHeap) Less(i, j int) bool { return h[i].Size < h[j].Size }
+func (h fileUsageHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *fileUsageHeap) Push(x any)        { *h = append(*h, x.(FileUsage)) }
+
+func (h *fileUsageHeap) Pop() any {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
+
+// walkTreeSummary computes the usage of isiPath by walking it.
+func (c *Client) walkTreeSummary(ctx context.Context, isiPath string, opts *TreeSummaryOptions) (*TreeSummary, error) {
+	now := time.Now()
+	s := &TreeSummary{Path: isiPath, Source: TreeSummarySourceWalk, AsOf: now}
+	bounds := slices.Clone(opts.AgeBuckets)
+	slices.Sort(bounds)
+	if len(bounds) > 0 {
+		s.Ages = make([]AgeBucket, len(bounds)+1)
+		for i, bound := range bounds {
+			s.Ages[i].MaxAge = bound
+		}
+	}
+	largest := &fileUsageHeap{}
+
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = ConcurrentHTTPConnections
+	}
+	walkOpts := &WalkOptions{Workers: workers, Detail: []string{"size", "last_modified"}}
+	// the walk function is never called concurrently
+	err := c.WalkWithOptions(ctx, isiPath, walkOpts, func(p string, d *DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if p == isiPath {
+			return nil
+		}
+		if opts.MaxEntries > 0 && s.Inodes >= opts.MaxEntries {
+			s.Truncated = true
+			return fs.SkipAll
+		}
+		s.Inodes++
+		s.Size += d.Size()
+		if d.IsDir() {
+			s.Dirs++
+			return nil
+		}
+		if d.Type() != 0 {
+			return nil
+		}
+		s.Files++
+		if opts.Largest > 0 {
+			heap.Push(largest, FileUsage{Path: p, Size: d.Size(), ModTime: d.ModTime()})
+			if largest.Len() > opts.Largest {
+				heap.Pop(largest)
+			}
+		}
+		if len(s.Ages) > 0 {
+			age := now.Sub(d.ModTime())
+			i := sort.Search(len(bounds), func(i int) bool { return age <= bounds[i] })
+			s.Ages[i].Files++
+			s.Ages[i].Size += d.Size()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.Largest = make([]FileUsage, largest.Len())
+	for i := len(s.Largest) - 1; i >= 0; i-- {
+		s.Largest[i] = heap.Pop(largest).(FileUsage)
+	}
+	return s, nil
+}
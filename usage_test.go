@@ -0,0 +1,156 @@
+/*
+Copyright (c) 2025 Dell Inc, or its subsidiaries.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package goisilon
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/dell/goisilon/api"
+	apiv1 "github.com/dell/goisilon/api/v1"
+	apiv3 "github.com/dell/goisilon/api/v3"
+	"github.com/dell/goisilon/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func mockQuotaUsage(c *Client, isiPath string, quotas ...*apiv1.IsiQuota) {
+	call := c.API.(*mocks.Client).On("Get", mock.Anything, "platform/1/quota/quotas", "", mock.MatchedBy(func(params api.OrderedValues) bool {
+		return params.StringGet("path") == isiPath && params.StringGet("type") == "directory"
+	}), mock.Anything, mock.Anything)
+	if len(quotas) == 0 {
+		call.Return(errors.New("Quota not found: " + isiPath)).Once()
+		return
+	}
+	call.Return(nil).Run(func(args mock.Arguments) {
+		args.Get(5).(*apiv1.IsiQuotaListRespResume).Quotas = quotas
+	}).Once()
+}
+
+func TestSummarizeTreeQuota(t *testing.T) {
+	ctx := context.Background()
+	client := &Client{API: new(mocks.Client)}
+
+	quota := &apiv1.IsiQuota{Type: "directory", Ready: true, Path: "/ifs/data"}
+	quota.Usage.Logical = 4096
+	quota.Usage.Inodes = 9
+	mockQuotaUsage(client, "/ifs/data", quota)
+
+	s, err := client.SummarizeTree(ctx, "/ifs/data/", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, TreeSummarySourceQuota, s.Source)
+	assert.Equal(t, int64(4096), s.Size)
+	assert.Equal(t, int64(9), s.Inodes)
+}
+
+func TestSummarizeTreeQuotaSelection(t *testing.T) {
+	ctx := context.Background()
+	client := &Client{API: new(mocks.Client)}
+
+	user := &apiv1.IsiQuota{Type: "user", Ready: true, Path: "/ifs/data"}
+	user.Usage.Logical = 1
+	snaps := &apiv1.IsiQuota{Type: "directory", Ready: true, Path: "/ifs/data", IncludeSnapshots: true}
+	snaps.Usage.Logical = 8192
+	quota := &apiv1.IsiQuota{Type: "directory", Ready: true, Path: "/ifs/data"}
+	quota.Usage.Logical = 4096
+	mockQuotaUsage(client, "/ifs/data", user, snaps, quota)
+
+	s, err := client.SummarizeTree(ctx, "/ifs/data", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, TreeSummarySourceQuota, s.Source)
+	assert.Equal(t, int64(4096), s.Size)
+
+	// only a quota that counts snapshots, so the tree is walked
+	mockQuotaUsage(client, "/ifs/data", snaps)
+	mockNamespaceTree(client, testTree, nil)
+	s, err = client.SummarizeTree(ctx, "/ifs/data", &TreeSummaryOptions{SkipFSA: true})
+	assert.NoError(t, err)
+	assert.Equal(t, TreeSummarySourceWalk, s.Source)
+}
+
+func TestSummarizeTreeFSA(t *testing.T) {
+	ctx := context.Background()
+	client := &Client{API: new(mocks.Client)}
+	mockQuotaUsage(client, "/ifs/data")
+
+	end := time.Now().Add(-time.Hour).Unix()
+	client.API.(*mocks.Client).On("Get", mock.Anything, "platform/3/fsa/results", "", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		args.Get(5).(*apiv3.IsiFsaResults).Results = []*apiv3.IsiFsaResult{{ID: 1, EndTime: end - 3600}, {ID: 2, EndTime: end}, {ID: 3}}
+	})
+	client.API.(*mocks.Client).On("Get", mock.Anything, "platform/3/fsa/results/2/directories", "", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		args.Get(5).(*apiv3.IsiFsaDirectories).Directories = []*apiv3.IsiFsaDirectory{
+			{Name: "data", FileCount: 6, DirCount: 3, OtherCount: 1, LogicalSize: 19},
+		}
+	}).Once()
+
+	s, err := client.SummarizeTree(ctx, "/ifs/data", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, &TreeSummary{
+		Path: "/ifs/data", Source: TreeSummarySourceFSA, AsOf: time.Unix(end, 0),
+		Size: 19, Inodes: 10, Files: 6, Dirs: 3,
+	}, s)
+
+	// the FSA result is too old, so the tree is walked
+	mockQuotaUsage(client, "/ifs/data")
+	mockNamespaceTree(client, testTree, nil)
+	s, err = client.SummarizeTree(ctx, "/ifs/data", &TreeSummaryOptions{MaxFSAAge: time.Minute})
+	assert.NoError(t, err)
+	assert.Equal(t, TreeSummarySourceWalk, s.Source)
+}
+
+func TestSummarizeTreeWalk(t *testing.T) {
+	ctx := context.Background()
+	client := &Client{API: new(mocks.Client)}
+	tree := map[string][]apiv1.IsiNamespaceEntry{}
+	for k, v := range testTree {
+		tree[k] = v
+	}
+	recent := nsFile("recent", 5)
+	recent.LastModified = time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat)
+	tree["ifs/data/b"] = append([]apiv1.IsiNamespaceEntry{recent}, tree["ifs/data/b"]...)
+	mockNamespaceTree(client, tree, nil)
+
+	s, err := client.SummarizeTree(ctx, "/ifs/data", &TreeSummaryOptions{
+		Largest:    2,
+		AgeBuckets: []time.Duration{100 * 365 * 24 * time.Hour, 24 * time.Hour},
+		Workers:    3,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, TreeSummarySourceWalk, s.Source)
+	assert.Equal(t, int64(24), s.Size)
+	assert.Equal(t, int64(6), s.Files)
+	assert.Equal(t, int64(3), s.Dirs)
+	assert.Equal(t, int64(9), s.Inodes)
+	assert.False(t, s.Truncated)
+	assert.Equal(t, []string{"/ifs/data/b/deep/x", "/ifs/data/b/recent"}, []string{s.Largest[0].Path, s.Largest[1].Path})
+	assert.Equal(t, []AgeBucket{
+		{MaxAge: 24 * time.Hour, Files: 1, Size: 5},
+		{MaxAge: 100 * 365 * 24 * time.Hour, Files: 5, Size: 19},
+		{},
+	}, s.Ages)
+
+	s, err = client.SummarizeTree(ctx, "/ifs/data", &TreeSummaryOptions{SkipQuota: true, SkipFSA: true, MaxEntries: 4})
+	assert.NoError(t, err)
+	assert.True(t, s.Truncated)
+	assert.Equal(t, int64(4), s.Inodes)
+
+	_, err = client.SummarizeTree(ctx, "/ifs/data", &TreeSummaryOptions{AgeBuckets: []time.Duration{0}})
+	assert.EqualError(t, err, "age bucket bounds must be positive")
+}
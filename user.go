@@ -90,3 +90,14 @@ func (c *Client) UpdateUserByNameOrUID(
 func (c *Client) DeleteUserByNameOrUID(ctx context.Context, name *string, uid *int32) error {
 	return api.DeleteIsiUser(ctx, c.API, name, uid)
 }
+
+// UserAccess is the effective access of a user to a file or directory.
+type UserAccess = api.IsiUserAccess
+
+// GetUserAccess returns the effective access of the user identified by user
+// name, uid or sid to the file or directory at the absolute path isiPath, with
+// the owner, group and mode of the file and the ACEs relevant to the user.
+// Optional: zone, the access zone of the user.
+func (c *Client) GetUserAccess(ctx context.Context, name *string, uid *int32, sid *string, isiPath string, zone *string) (*UserAccess, error) {
+	return api.GetIsiUserAccess(ctx, c.API, name, uid, sid, isiPath, zone)
+}
@@ -200,3 +200,21 @@ func TestDeleteUserByNameOrUID(t *testing.T) {
 	// Assert no error
 	assert.NoError(t, err)
 }
+
+func TestGetUserAccess(t *testing.T) {
+	ctx := context.Background()
+	name := "alice"
+	client := &Client{API: new(mocks.Client)}
+	client.API.(*mocks.Client).On("Get", ctx, "platform/1/auth/access", "USER:alice", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		resp := args.Get(5).(**apiv1.IsiUserAccessResp)
+		*resp = &apiv1.IsiUserAccessResp{Access: []*apiv1.IsiUserAccess{{
+			ID:          "USER:alice",
+			Permissions: apiv1.IsiAccessPermissions{Expected: "file_gen_read"},
+		}}}
+	}).Once()
+
+	access, err := client.GetUserAccess(ctx, &name, nil, nil, "/ifs/data/file.txt", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "USER:alice", access.ID)
+	assert.False(t, access.HasRights("file_write"))
+}
@@ -21,8 +21,6 @@ import (
 	"fmt"
 	"os"
 	"path"
-	"strings"
-	"sync"
 
 	log "github.com/akutz/gournal"
 	apiv1 "github.com/dell/goisilon/api/v1"
@@ -204,94 +202,10 @@ func newConcurrentHTTPChan() chan bool {
 }
 
 // ForceDeleteVolume force deletes a volume by resetting the ownership of
-// all descendent directories to the current user prior to issuing a delete
-// call.
+// all descendent directories to the current user prior to issuing a
+// recursive delete call, see DeleteTree.
 func (c *Client) ForceDeleteVolume(ctx context.Context, name string) error {
-	var (
-		user       = c.API.User()
-		vpl        = len(c.API.VolumesPath()) + 1
-		errs       = make(chan error)
-		queryDone  = make(chan int)
-		childPaths = make(chan string)
-		setACLWait = &sync.WaitGroup{}
-		setACLChan = newConcurrentHTTPChan()
-		setACLDone = make(chan int)
-		mode       = apiv2.FileMode(0o755)
-		acl        = &apiv2.ACL{
-			Action:        &apiv2.PActionTypeReplace,
-			Authoritative: &apiv2.PAuthoritativeTypeMode,
-			Owner: &apiv2.Persona{
-				ID: &apiv2.PersonaID{
-					ID:   user,
-					Type: apiv2.PersonaIDTypeUser,
-				},
-			},
-			Mode: &mode,
-		}
-	)
-
-	go func() {
-		queryChan, queryErrs := apiv2.ContainerChildrenGetQuery(
-			ctx, c.API, name, 1000, -1, "container", "ASC",
-			[]string{"container_path", "name"},
-			[]string{"owner", "name", "container_path"})
-
-		go func() {
-			if err := <-queryErrs; err != nil {
-				errs <- err
-				close(errs)
-			}
-		}()
-
-		go func() {
-			for child := range queryChan {
-				if strings.EqualFold(user, *child.Owner) {
-					continue
-				}
-				setACLWait.Add(1)
-				go func(s string) {
-					childPaths <- s
-				}(path.Join(*child.Path, *child.Name)[vpl:])
-			}
-			close(queryDone)
-		}()
-	}()
-
-	go func() {
-		for childPath := range childPaths {
-			go func(childPath string) {
-				<-setACLChan
-				if err := apiv2.ACLUpdate(
-					ctx,
-					c.API,
-					childPath,
-					acl); err != nil {
-					go func(childPath string) {
-						childPaths <- childPath
-					}(childPath)
-				} else {
-					setACLWait.Done()
-				}
-				setACLChan <- true
-			}(childPath)
-		}
-	}()
-
-	go func() {
-		<-queryDone
-		setACLWait.Wait()
-		close(setACLDone)
-	}()
-
-	select {
-	case <-setACLDone:
-	case err := <-errs:
-		if err != nil {
-			return err
-		}
-	}
-
-	return c.DeleteVolume(ctx, name)
+	return c.DeleteTree(ctx, path.Join(c.API.VolumesPath(), name), &DeleteOptions{FixOwnership: true, Recursive: true})
 }
 
 // CopyVolume creates a volume based on an existing volume
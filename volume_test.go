@@ -1175,10 +1175,16 @@ func TestGetVolumeSize(t *testing.T) {
 
 func TestForceDeleteVolume(t *testing.T) {
 	// Test case: successful deletion
-	client.API.(*mocks.Client).On("User", anyArgs[0:6]...).Return("user").Run(nil).Once()
-	client.API.(*mocks.Client).On("VolumesPath", anyArgs[0:6]...).Return("").Run(nil).Times(3)
-	client.API.(*mocks.Client).On("Get", anyArgs[0:6]...).Return(nil).Run(nil).Once()
-	client.API.(*mocks.Client).On("Delete", anyArgs[0:6]...).Return(nil).Once()
+	client := &Client{API: new(mocks.Client)}
+	client.API.(*mocks.Client).On("User", anyArgs[0:6]...).Return("user")
+	client.API.(*mocks.Client).On("VolumesPath", anyArgs[0:6]...).Return("/ifs/volumes").Once()
+	tree := map[string][]apiv1.IsiNamespaceEntry{"ifs/volumes/testvol": {nsDir("dir")}, "ifs/volumes/testvol/dir": {}}
+	mockNamespaceFiles(client, tree)
+	mockNamespaceTree(client, tree, nil)
+	client.API.(*mocks.Client).On("Put", mock.Anything, "namespace", "ifs/volumes/testvol/dir", mock.Anything, mock.Anything, mock.Anything, nil).Return(nil).Once()
+	deleted := mockNamespaceDelete(client, nil)
 	err := client.ForceDeleteVolume(context.Background(), "testvol")
 	assert.NoError(t, err)
+	assert.Equal(t, []string{"ifs/volumes/testvol"}, *deleted)
+	client.API.(*mocks.Client).AssertNumberOfCalls(t, "Put", 1)
 }
@@ -0,0 +1,293 @@
+/*
+Copyright (c) 2025 Dell Inc, or its subsidiaries.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package goisilon
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"iter"
+	"net/http"
+	"path"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	apiv1 "github.com/dell/goisilon/api/v1"
+)
+
+const defaultWalkPageSize = 1000
+
+// DirEntry is a file or directory in the OneFS namespace. It implements both
+// fs.DirEntry and fs.FileInfo. Only the attributes requested when listing
+// its directory are set.
+type DirEntry struct {
+	apiv1.IsiNamespaceEntry
+}
+
+// Name returns the base name of the entry.
+func (d *DirEntry) Name() string { return d.IsiNamespaceEntry.Name }
+
+// IsDir reports whether the entry is a directory.
+func (d *DirEntry) IsDir() bool {
+	return d.IsiNamespaceEntry.Type == apiv1.IsiNamespaceEntryTypeContainer
+}
+
+// Info returns the entry itself.
+func (d *DirEntry) Info() (fs.FileInfo, error) { return d, nil }
+
+// Size returns the size of the entry in bytes.
+func (d *DirEntry) Size() int64 { return d.IsiNamespaceEntry.Size }
+
+// Mode returns the permission and type bits of the entry.
+func (d *DirEntry) Mode() fs.FileMode {
+	perm, _ := strconv.ParseUint(d.IsiNamespaceEntry.Mode, 8, 32)
+	return fs.FileMode(perm)&fs.ModePerm | d.Type()
+}
+
+// Type returns the type bits of the entry.
+func (d *DirEntry) Type() fs.FileMode {
+	switch d.IsiNamespaceEntry.Type {
+	case apiv1.IsiNamespaceEntryTypeContainer:
+		return fs.ModeDir
+	case "symbolic_link":
+		return fs.ModeSymlink
+	case "pipe":
+		return fs.ModeNamedPipe
+	case "socket":
+		return fs.ModeSocket
+	case "character_device":
+		return fs.ModeDevice | fs.ModeCharDevice
+	case "block_device":
+		return fs.ModeDevice
+	}
+	return 0
+}
+
+// ModTime returns the last modification time of the entry.
+func (d *DirEntry) ModTime() time.Time {
+	t, _ := http.ParseTime(d.LastModified)
+	return t
+}
+
+// Sys returns the namespace API attributes of the entry.
+func (d *DirEntry) Sys() any { return &d.IsiNamespaceEntry }
+
+// WalkOptions configures WalkWithOptions.
+type WalkOptions struct {
+	// MaxDepth limits how deep below the root entries are visited, 1 visits
+	// only the children of the root. 0 means no limit.
+	MaxDepth int
+	// Workers is the number of directories listed concurrently, it defaults to 1.
+	Workers int
+	// Detail is the set of attributes requested for every entry, it defaults
+	// to apiv1.IsiNamespaceDetail. The name and type are always requested.
+	Detail []string
+	// PageSize is the number of entries requested at once, it defaults to 1000.
+	PageSize int
+}
+
+// WalkFunc is called by Walk for every entry, see fs.WalkDirFunc.
+type WalkFunc func(path string, d *DirEntry, err error) error
+
+type walker struct {
+	c      *Client
+	ctx    context.Context
+	cancel context.CancelFunc
+	opts   WalkOptions
+	fn     WalkFunc
+
+	mu      sync.Mutex // serializes the calls of fn
+	sem     chan struct{}
+	wg      sync.WaitGroup
+	once    sync.Once
+	err     error
+	stopped atomic.Bool
+}
+
+func (w *walker) call(p string, d *DirEntry, err error) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.stopped.Load() {
+		return fs.SkipAll
+	}
+	// the walk is stopped before the lock is released so that fn is not
+	// called again by another worker
+	if err = w.fn(p, d, err); err != nil && err != fs.SkipDir {
+		w.stop(err)
+	}
+	return err
+}
+
+// stop ends the walk, err is returned by Walk unless it is fs.SkipAll.
+func (w *walker) stop(err error) {
+	w.once.Do(func() {
+		if err != fs.SkipAll {
+			w.err = err
+		}
+		w.stopped.Store(true)
+		w.cancel()
+	})
+}
+
+// walkDir visits the children of dir, which is at the given depth below the root.
+func (w *walker) walkDir(dir string, d *DirEntry, depth int) error {
+	resume := ""
+	for {
+		if w.stopped.Load() {
+			return fs.SkipAll
+		}
+		page, err := apiv1.GetIsiDirectoryChildren(w.ctx, w.c.API, dir, w.opts.Detail, w.opts.PageSize, resume)
+		if err != nil {
+			if w.stopped.Load() {
+				return fs.SkipAll
+			}
+			if err := w.call(dir, d, err); err != nil && err != fs.SkipDir {
+				return err
+			}
+			return nil
+		}
+		for i := range page.Children {
+			child := &DirEntry{page.Children[i]}
+			p := path.Join(dir, child.Name())
+			if err := w.call(p, child, nil); err != nil {
+				if err != fs.SkipDir {
+					return err
+				}
+				if child.IsDir() {
+					continue
+				}
+				// skip the remaining entries of the directory
+				return nil
+			}
+			if child.IsDir() && (w.opts.MaxDepth <= 0 || depth+1 < w.opts.MaxDepth) {
+				if err := w.descend(p, child, depth+1); err != nil {
+					return err
+				}
+			}
+		}
+		if page.Resume == "" {
+			return nil
+		}
+		resume = page.Resume
+	}
+}
+
+// descend walks dir on a new worker if one is available, or else in place.
+func (w *walker) descend(dir string, d *DirEntry, depth int) error {
+	select {
+	case w.sem <- struct{}{}:
+		w.wg.Add(1)
+		go func() {
+			defer w.wg.Done()
+			defer func() { <-w.sem }()
+			if err := w.walkDir(dir, d, depth); err != nil {
+				w.stop(err)
+			}
+		}()
+		return nil
+	default:
+		return w.walkDir(dir, d, depth)
+	}
+}
+
+// Walk walks the directory tree at the absolute path root like fs.WalkDir,
+// calling fn for root and every entry below it. Directories are listed one
+// page at a time, so memory use does not grow with the size of the tree.
+func (c *Client) Walk(ctx context.Context, root string, fn WalkFunc) error {
+	return c.WalkWithOptions(ctx, root, nil, fn)
+}
+
+// WalkWithOptions is Walk configured by opts.
+//
+// The entries of a directory are visited in lexical order. With more than one
+// worker, subdirectories are walked concurrently and entries of different
+// directories are visited in no particular order, but fn is never called
+// concurrently. Returning fs.SkipDir from fn skips a directory, or the
+// remaining entries of the directory of a file, and fs.SkipAll ends the walk.
+//
+// fn is first called for root with an entry that only carries its name. If
+// root can not be listed, fn is called for it again with the error.
+func (c *Client) WalkWithOptions(ctx context.Context, root string, opts *WalkOptions, fn WalkFunc) error {
+	if fn == nil {
+		return errors.New("no walk function set")
+	}
+	w := &walker{c: c, fn: fn}
+	if opts != nil {
+		w.opts = *opts
+	}
+	if w.opts.PageSize <= 0 {
+		w.opts.PageSize = defaultWalkPageSize
+	}
+	if w.opts.Workers > 1 {
+		w.sem = make(chan struct{}, w.opts.Workers-1)
+	}
+	w.ctx, w.cancel = context.WithCancel(ctx)
+	defer w.cancel()
+
+	root = path.Clean(root)
+	d := &DirEntry{apiv1.IsiNamespaceEntry{Name: path.Base(root), Type: apiv1.IsiNamespaceEntryTypeContainer}}
+	err := w.call(root, d, nil)
+	if err == nil {
+		err = w.walkDir(root, d, 0)
+	}
+	if err != nil && err != fs.SkipDir {
+		w.stop(err)
+	}
+	w.wg.Wait()
+	return w.err
+}
+
+// WalkEntry is an entry visited by an iterator returned by Entries.
+type WalkEntry struct {
+	Path  string
+	Entry *DirEntry
+}
+
+// Entries returns an iterator over the entries below the absolute path root,
+// not including root itself, walking the tree as WalkWithOptions does.
+// Iteration stops after the first error.
+func (c *Client) Entries(ctx context.Context, root string, opts *WalkOptions) iter.Seq2[WalkEntry, error] {
+	return func(yield func(WalkEntry, error) bool) {
+		root := path.Clean(root)
+		done := false
+		err := c.WalkWithOptions(ctx, root, opts, func(p string, d *DirEntry, err error) error {
+			if done {
+				return fs.SkipAll
+			}
+			if err != nil {
+				return err
+			}
+			if p == root {
+				return nil
+			}
+			if !yield(WalkEntry{Path: p, Entry: d}, nil) {
+				done = true
+				return fs.SkipAll
+			}
+			return nil
+		})
+		if err != nil && !done {
+			yield(WalkEntry{}, err)
+		}
+	}
+}
+
+var (
+	_ fs.DirEntry = (*DirEntry)(nil)
+	_ fs.FileInfo = (*DirEntry)(nil)
+)
@@ -0,0 +1,220 @@
+/*
+Copyright (c) 2025 Dell Inc, or its subsidiaries.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package goisilon
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dell/goisilon/api"
+	apiv1 "github.com/dell/goisilon/api/v1"
+	"github.com/dell/goisilon/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// mockNamespaceTree serves directory listings of tree, keyed by the directory
+// path without the leading slash, two entries per page. Listing a directory
+// in failing returns its error.
+func mockNamespaceTree(c *Client, tree map[string][]apiv1.IsiNamespaceEntry, failing map[string]error) {
+	c.API.(*mocks.Client).On("Get", mock.Anything, "namespace", mock.Anything, mock.Anything, mock.Anything, mock.AnythingOfType("*v1.IsiNamespaceChildren")).Return(
+		func(_ context.Context, _, id string, params api.OrderedValues, _ map[string]string, resp interface{}) error {
+			if err := failing[id]; err != nil {
+				return err
+			}
+			entries, ok := tree[id]
+			if !ok {
+				return &api.JSONError{StatusCode: 404}
+			}
+			start := 0
+			if resume, ok := params.StringGetOk("resume"); ok {
+				start, _ = strconv.Atoi(resume)
+			}
+			end := min(start+2, len(entries))
+			page := resp.(*apiv1.IsiNamespaceChildren)
+			page.Children = entries[start:end]
+			if end < len(entries) {
+				page.Resume = strconv.Itoa(end)
+			}
+			return nil
+		})
+}
+
+func nsDir(name string) apiv1.IsiNamespaceEntry {
+	return apiv1.IsiNamespaceEntry{Name: name, Type: apiv1.IsiNamespaceEntryTypeContainer, Mode: "0755"}
+}
+
+func nsFile(name string, size int64) apiv1.IsiNamespaceEntry {
+	return apiv1.IsiNamespaceEntry{Name: name, Type: "object", Size: size, Mode: "0644", LastModified: "Thu, 02 Jan 2025 03:04:05 GMT"}
+}
+
+var testTree = map[string][]apiv1.IsiNamespaceEntry{
+	"ifs/data":        {nsDir("a"), nsDir("b"), nsFile("c.txt", 3)},
+	"ifs/data/a":      {nsFile("1", 1), nsFile("2", 2), nsFile("3", 3)},
+	"ifs/data/b":      {nsDir("deep")},
+	"ifs/data/b/deep": {nsFile("x", 10)},
+}
+
+func TestWalk(t *testing.T) {
+	ctx := context.Background()
+	client := &Client{API: new(mocks.Client)}
+	mockNamespaceTree(client, testTree, nil)
+
+	var paths []string
+	err := client.Walk(ctx, "/ifs/data/", func(p string, d *DirEntry, err error) error {
+		assert.NoError(t, err)
+		paths = append(paths, p)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"/ifs/data", "/ifs/data/a", "/ifs/data/a/1", "/ifs/data/a/2", "/ifs/data/a/3",
+		"/ifs/data/b", "/ifs/data/b/deep", "/ifs/data/b/deep/x", "/ifs/data/c.txt",
+	}, paths)
+
+	paths = nil
+	err = client.WalkWithOptions(ctx, "/ifs/data", &WalkOptions{MaxDepth: 2}, func(p string, d *DirEntry, _ error) error {
+		paths = append(paths, p)
+		if p == "/ifs/data/a" {
+			return fs.SkipDir
+		}
+		if p == "/ifs/data/b/deep" {
+			assert.True(t, d.IsDir())
+			assert.Equal(t, fs.ModeDir|0o755, d.Mode())
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/ifs/data", "/ifs/data/a", "/ifs/data/b", "/ifs/data/b/deep", "/ifs/data/c.txt"}, paths)
+
+	paths = nil
+	err = client.Walk(ctx, "/ifs/data", func(p string, _ *DirEntry, _ error) error {
+		paths = append(paths, p)
+		if p == "/ifs/data/a/2" {
+			return fs.SkipAll
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "/ifs/data/a/2", paths[len(paths)-1])
+}
+
+func TestWalkErrors(t *testing.T) {
+	ctx := context.Background()
+	client := &Client{API: new(mocks.Client)}
+	mockNamespaceTree(client, testTree, map[string]error{"ifs/data/a": errors.New("permission denied")})
+
+	var failed []string
+	err := client.Walk(ctx, "/ifs/data", func(p string, _ *DirEntry, err error) error {
+		if err != nil {
+			failed = append(failed, p)
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/ifs/data/a"}, failed)
+
+	err = client.Walk(ctx, "/ifs/data", func(_ string, _ *DirEntry, err error) error {
+		return err
+	})
+	assert.EqualError(t, err, "permission denied")
+
+	err = client.Walk(ctx, "/ifs/data", nil)
+	assert.EqualError(t, err, "no walk function set")
+}
+
+func TestWalkConcurrent(t *testing.T) {
+	ctx := context.Background()
+	client := &Client{API: new(mocks.Client)}
+	mockNamespaceTree(client, testTree, nil)
+
+	var paths []string
+	var size int64
+	for entry, err := range client.Entries(ctx, "/ifs/data", &WalkOptions{Workers: 4}) {
+		assert.NoError(t, err)
+		paths = append(paths, entry.Path)
+		size += entry.Entry.Size()
+	}
+	sort.Strings(paths)
+	assert.Len(t, paths, 8)
+	assert.Equal(t, int64(19), size)
+
+	n := 0
+	for entry := range client.Entries(ctx, "/ifs/data", &WalkOptions{Workers: 4}) {
+		assert.True(t, strings.HasPrefix(entry.Path, "/ifs/data/"))
+		n++
+		if n == 3 {
+			break
+		}
+	}
+	assert.Equal(t, 3, n)
+
+	for _, err := range client.Entries(ctx, "/ifs/missing", nil) {
+		assert.Error(t, err)
+	}
+}
+
+func TestEntriesBreak(t *testing.T) {
+	ctx := context.Background()
+	client := &Client{API: new(mocks.Client)}
+	tree := map[string][]apiv1.IsiNamespaceEntry{"ifs/data": nil}
+	for i := 0; i < 8; i++ {
+		dir := "d" + strconv.Itoa(i)
+		tree["ifs/data"] = append(tree["ifs/data"], nsDir(dir))
+		tree["ifs/data/"+dir] = []apiv1.IsiNamespaceEntry{nsFile("1", 1), nsFile("2", 2), nsFile("3", 3)}
+	}
+	client.API.(*mocks.Client).On("Get", mock.Anything, "namespace", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, _, id string, _ api.OrderedValues, _ map[string]string, resp interface{}) error {
+			time.Sleep(time.Millisecond)
+			if id == "ifs/data/d7" {
+				return errors.New("listing failed")
+			}
+			resp.(*apiv1.IsiNamespaceChildren).Children = tree[id]
+			return nil
+		})
+
+	for i := 0; i < 20; i++ {
+		var calls, stopped int
+		client.Entries(ctx, "/ifs/data", &WalkOptions{Workers: 8})(func(_ WalkEntry, _ error) bool {
+			calls++
+			if stopped > 0 {
+				stopped++
+			}
+			if calls == 5 {
+				// let the other workers finish their listings and wait for the lock
+				time.Sleep(5 * time.Millisecond)
+				stopped = 1
+			}
+			return calls < 5
+		})
+		assert.Equal(t, 1, stopped, "yield called after it returned false")
+	}
+
+	// the walk is stopped before another worker can take the lock
+	w := &walker{fn: func(string, *DirEntry, error) error { return fs.SkipAll }}
+	w.ctx, w.cancel = context.WithCancel(ctx)
+	defer w.cancel()
+	assert.Equal(t, fs.SkipAll, w.call("/ifs/data", nil, nil))
+	assert.True(t, w.stopped.Load())
+	assert.NoError(t, w.err)
+}